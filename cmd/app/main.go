@@ -25,6 +25,13 @@ func main() {
 		application.Server.Run()
 	}()
 
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workersDone := make(chan struct{})
+	go func() {
+		application.RunWorkers(workersCtx)
+		close(workersDone)
+	}()
+
 	slog.Info("application started")
 
 	stop := make(chan os.Signal, 1)
@@ -32,8 +39,17 @@ func main() {
 
 	<-stop
 
+	stopWorkers()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	application.Server.Stop(ctx)
+
+	// ждём, пока воркеры допишут начатое, но не дольше таймаута остановки
+	select {
+	case <-workersDone:
+	case <-ctx.Done():
+		log.Warn("workers did not stop before shutdown timeout")
+	}
 }
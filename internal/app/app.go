@@ -1,24 +1,41 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"math/rand"
+	"strings"
+	"sync"
 
 	"github.com/3eLLenKa/test-avito/internal/config"
 	api "github.com/3eLLenKa/test-avito/internal/delivery/http/gen"
 	"github.com/3eLLenKa/test-avito/internal/delivery/http/handlers"
+	"github.com/3eLLenKa/test-avito/internal/delivery/http/ingest"
 	"github.com/3eLLenKa/test-avito/internal/delivery/http/server"
+	"github.com/3eLLenKa/test-avito/internal/notify"
+	"github.com/3eLLenKa/test-avito/internal/outbox"
 	"github.com/3eLLenKa/test-avito/internal/repository"
 	"github.com/3eLLenKa/test-avito/internal/repository/postgres"
+	"github.com/3eLLenKa/test-avito/internal/reviewsync"
 	"github.com/3eLLenKa/test-avito/internal/service"
+	"github.com/3eLLenKa/test-avito/internal/webhook"
 	"github.com/gin-gonic/gin"
 )
 
 type App struct {
-	Server *server.Server
+	Server   *server.Server
+	Relay    *Relay
+	Digest   *Digest
+	SLA      *SLAScheduler
+	Fairness *FairnessMonitor
 }
 
 func NewApp(log *slog.Logger, cfg *config.Config) *App {
+	if err := checkOutbox(cfg); err != nil {
+		panic(err)
+	}
+
 	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
 		cfg.Database.Host,
@@ -35,7 +52,25 @@ func NewApp(log *slog.Logger, cfg *config.Config) *App {
 	}
 
 	repo := repository.New(pg.Db)
-	svc := service.New(log, repo.PullRequest, repo.Team, repo.User)
+
+	picker, err := service.NewReviewerPicker(cfg.PR.Strategy, cfg.PR.Weights, repo.PullRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	opts := []service.Option{
+		service.WithPicker(picker),
+		service.WithConfig(cfg.PR),
+		service.WithFairness(cfg.Fairness),
+	}
+	if cfg.PR.Seed != 0 {
+		opts = append(opts, service.WithRandSource(rand.NewSource(cfg.PR.Seed)))
+	}
+
+	dispatcher := webhook.NewDispatcher(repo.Webhooks, cfg.Outbox.Webhook.Timeout)
+	opts = append(opts, service.WithWebhookDispatcher(dispatcher))
+
+	svc := service.New(log, repo.PullRequest, repo.Team, repo.User, repo.CodeOwners, repo.Webhooks, opts...)
 
 	handler := api.NewStrictHandler(
 		handlers.NewHandlers(svc),
@@ -46,11 +81,159 @@ func NewApp(log *slog.Logger, cfg *config.Config) *App {
 	router.Use(gin.Recovery())
 	api.RegisterHandlers(router, handler)
 
+	integrations := []struct {
+		provider ingest.Provider
+		cfg      config.Integration
+	}{
+		{ingest.GitHub{}, cfg.Integrations.GitHub},
+		{ingest.GitLab{}, cfg.Integrations.GitLab},
+		{ingest.Gitea{}, cfg.Integrations.Gitea},
+	}
+	for _, integration := range integrations {
+		if !integration.cfg.Enabled {
+			continue
+		}
+		h, err := ingest.New(log, svc, integration.provider, integration.cfg)
+		if err != nil {
+			panic(err)
+		}
+		router.POST("/integrations/"+integration.provider.Name()+"/webhook", h.Handle)
+	}
+
 	addr := ":" + cfg.App.Port
 
 	httpServer := server.New(addr, router)
 
-	return &App{
-		Server: httpServer,
+	application := &App{Server: httpServer}
+
+	sinks := []outbox.Sink{dispatcher}
+	if smtpCfg := cfg.Notifications.SMTP; smtpCfg.Enabled {
+		email, err := notify.NewEmail(log, repo.User, repo.Outbox, smtpCfg)
+		if err != nil {
+			panic(err)
+		}
+		sinks = append(sinks, email)
+
+		if smtpCfg.Digest.Enabled {
+			if application.Digest, err = NewDigest(log, svc, email, smtpCfg.Digest); err != nil {
+				panic(err)
+			}
+		}
+	}
+	application.Relay = newRelay(log, cfg, repo, sinks...)
+
+	if cfg.SLA.Enabled {
+		if application.SLA, err = NewSLAScheduler(log, svc, cfg.SLA); err != nil {
+			panic(err)
+		}
+	}
+	if cfg.Fairness.Monitor.Enabled {
+		if application.Fairness, err = NewFairnessMonitor(log, svc, cfg.Fairness.Monitor); err != nil {
+			panic(err)
+		}
+	}
+
+	return application
+}
+
+// RunWorkers запускает фоновые воркеры и возвращается, когда после отмены ctx завершатся все они
+func (a *App) RunWorkers(ctx context.Context) {
+	var wg sync.WaitGroup
+	if a.Relay != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Relay.Run(ctx)
+		}()
+	}
+	if a.Digest != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Digest.Run(ctx)
+		}()
+	}
+	if a.SLA != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.SLA.Run(ctx)
+		}()
+	}
+	if a.Fairness != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Fairness.Run(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// newRelay собирает релей outbox: к переданным sink'ам (подписки на вебхуки, email) добавляются
+// статичные webhook, log_file, синхронизация ревьюверов и чат — если включены в конфиге
+func newRelay(log *slog.Logger, cfg *config.Config, repo *repository.Repositories, sinks ...outbox.Sink) *Relay {
+	if !cfg.Outbox.Enabled {
+		log.Warn("outbox is disabled: webhook subscriptions will not receive events")
+		return nil
+	}
+
+	if cfg.Outbox.Webhook.URL != "" {
+		sinks = append(sinks, outbox.NewWebhookSink(cfg.Outbox.Webhook.URL, cfg.Outbox.Webhook.Timeout))
+	}
+	if cfg.Outbox.LogFile.Path != "" {
+		fileSink, err := outbox.NewFileSink(cfg.Outbox.LogFile.Path)
+		if err != nil {
+			panic(err)
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if ghSync := cfg.ReviewerSync.GitHub; ghSync.Enabled {
+		client := reviewsync.NewGitHubClient(ghSync.APIURL, ghSync.Token, ghSync.Timeout)
+		sinks = append(sinks, reviewsync.NewSink(log, client, cfg.Integrations.GitHub.Users, ghSync.Repos))
+	}
+	if cfg.Notifications.Chat.Enabled {
+		chat, err := notify.NewChat(log, repo.User, cfg.Notifications.Chat)
+		if err != nil {
+			panic(err)
+		}
+		sinks = append(sinks, chat)
+	}
+	return NewRelay(log, repo.Outbox, cfg.Outbox, sinks...)
+}
+
+// checkOutbox не даёт запуститься с выключенным outbox, если включено что-то, что работает только
+// через него: без релея такие события молча копились бы в outbox_events
+func checkOutbox(cfg *config.Config) error {
+	if cfg.Outbox.Enabled {
+		return nil
+	}
+
+	var needs []string
+	if cfg.Outbox.Webhook.URL != "" {
+		needs = append(needs, "outbox.webhook")
+	}
+	if cfg.Outbox.LogFile.Path != "" {
+		needs = append(needs, "outbox.log_file")
+	}
+	if cfg.ReviewerSync.GitHub.Enabled {
+		needs = append(needs, "reviewer_sync.github")
+	}
+	if cfg.Notifications.Chat.Enabled {
+		needs = append(needs, "notifications.chat")
+	}
+	if cfg.Notifications.SMTP.Enabled {
+		needs = append(needs, "notifications.smtp")
+	}
+	if cfg.SLA.Enabled && cfg.SLA.RemindAfter > 0 {
+		needs = append(needs, "sla.remind_after")
+	}
+	if cfg.Fairness.Monitor.Enabled {
+		needs = append(needs, "fairness.monitor")
+	}
+
+	if len(needs) > 0 {
+		return fmt.Errorf("outbox is disabled but required by: %s", strings.Join(needs, ", "))
 	}
+	return nil
 }
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type DigestSource interface {
+	ReviewDigests(ctx context.Context) ([]domain.ReviewDigest, error)
+}
+
+type DigestSender interface {
+	SendDigest(ctx context.Context, digests []domain.ReviewDigest) error
+}
+
+// Digest раз в сутки в заданное время рассылает сводку ожидающих ревью
+type Digest struct {
+	log    *slog.Logger
+	source DigestSource
+	sender DigestSender
+	hour   int
+	minute int
+	loc    *time.Location
+}
+
+func NewDigest(log *slog.Logger, source DigestSource, sender DigestSender, cfg config.EmailDigest) (*Digest, error) {
+	at, err := time.Parse("15:04", cfg.At)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest time %q, expected HH:MM: %w", cfg.At, err)
+	}
+
+	loc := time.Local
+	if cfg.Timezone != "" {
+		if loc, err = time.LoadLocation(cfg.Timezone); err != nil {
+			return nil, fmt.Errorf("invalid digest timezone %q: %w", cfg.Timezone, err)
+		}
+	}
+
+	return &Digest{
+		log:    log,
+		source: source,
+		sender: sender,
+		hour:   at.Hour(),
+		minute: at.Minute(),
+		loc:    loc,
+	}, nil
+}
+
+// Run блокируется до отмены ctx
+func (d *Digest) Run(ctx context.Context) {
+	for {
+		next := d.next(time.Now())
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		d.send(ctx)
+	}
+}
+
+func (d *Digest) send(ctx context.Context) {
+	digests, err := d.source.ReviewDigests(ctx)
+	if err != nil {
+		d.log.Error("failed to build review digests", slog.Any("err", err))
+		return
+	}
+	if len(digests) == 0 {
+		return
+	}
+
+	if err := d.sender.SendDigest(ctx, digests); err != nil {
+		d.log.Error("failed to send review digests", slog.Any("err", err))
+		return
+	}
+	d.log.Info("review digests sent", slog.Int("count", len(digests)))
+}
+
+// next — ближайший момент HH:MM после now в часовом поясе дайджеста
+func (d *Digest) next(now time.Time) time.Time {
+	now = now.In(d.loc)
+	next := time.Date(now.Year(), now.Month(), now.Day(), d.hour, d.minute, 0, 0, d.loc)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
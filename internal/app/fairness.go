@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type FairnessChecker interface {
+	CheckFairness(ctx context.Context, now time.Time, window time.Duration) ([]domain.TeamFairness, error)
+}
+
+// FairnessMonitor периодически пересчитывает равномерность назначений за скользящее окно.
+// Предупреждение team.assignment_skew пишет в outbox сервис — только при переходе порога.
+type FairnessMonitor struct {
+	log     *slog.Logger
+	checker FairnessChecker
+	cfg     config.FairnessMonitor
+}
+
+func NewFairnessMonitor(log *slog.Logger, checker FairnessChecker, cfg config.FairnessMonitor) (*FairnessMonitor, error) {
+	if cfg.CheckInterval <= 0 || cfg.Window <= 0 {
+		return nil, fmt.Errorf("fairness monitor check interval and window must be positive")
+	}
+	return &FairnessMonitor{log: log, checker: checker, cfg: cfg}, nil
+}
+
+// Run блокируется до отмены ctx
+func (m *FairnessMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *FairnessMonitor) check(ctx context.Context) {
+	crossed, err := m.checker.CheckFairness(ctx, time.Now(), m.cfg.Window)
+	if err != nil {
+		m.log.Error("failed to check assignment fairness", slog.Any("err", err))
+	}
+	for _, report := range crossed {
+		m.log.Warn("review assignments are skewed",
+			slog.String("team_name", report.TeamName),
+			slog.Float64("gini", report.Gini),
+			slog.Float64("threshold", report.Threshold),
+		)
+	}
+}
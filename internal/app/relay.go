@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/3eLLenKa/test-avito/internal/outbox"
+)
+
+type OutboxStore interface {
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id int64) error
+	MarkSinkPublished(ctx context.Context, id int64, sink string) error
+	MarkFailed(ctx context.Context, id int64, next time.Time, dead bool, errText string) error
+	Release(ctx context.Context, ids []int64) error
+}
+
+// Relay периодически забирает события из outbox и публикует их во все sink'и.
+// Событие считается опубликованным, только когда его приняли все sink'и. Успех запоминается
+// по каждому sink'у, и при ошибке с экспоненциальной задержкой повторяются только упавшие.
+type Relay struct {
+	log   *slog.Logger
+	store OutboxStore
+	sinks []outbox.Sink
+	cfg   config.Outbox
+}
+
+func NewRelay(log *slog.Logger, store OutboxStore, cfg config.Outbox, sinks ...outbox.Sink) *Relay {
+	return &Relay{
+		log:   log,
+		store: store,
+		sinks: sinks,
+		cfg:   cfg,
+	}
+}
+
+// Run блокируется до отмены ctx
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		r.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain публикует пачки, пока очередь не опустеет
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		events, err := r.store.ClaimDue(ctx, r.cfg.BatchSize, r.cfg.Lease)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				r.log.Error("failed to claim outbox events", slog.Any("err", err))
+			}
+			return
+		}
+
+		for i, event := range events {
+			if ctx.Err() != nil {
+				r.release(events[i:])
+				return
+			}
+			// начатое событие доводим до конца и при остановке, чтобы не оставлять его до истечения lease
+			r.publish(context.WithoutCancel(ctx), event)
+		}
+
+		if len(events) < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, event domain.OutboxEvent) {
+	var errs []error
+	for _, sink := range r.sinks {
+		if slices.Contains(event.PublishedSinks, sink.Name()) {
+			continue
+		}
+		if err := sink.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+			r.log.Warn("failed to publish outbox event",
+				slog.Int64("event_id", event.ID),
+				slog.String("event_type", string(event.Type)),
+				slog.String("sink", sink.Name()),
+				slog.Any("err", err),
+			)
+			continue
+		}
+		if err := r.store.MarkSinkPublished(ctx, event.ID, sink.Name()); err != nil {
+			// без отметки sink получит событие повторно — это допустимо при at-least-once
+			r.log.Error("failed to mark outbox event published to sink", slog.Int64("event_id", event.ID), slog.String("sink", sink.Name()), slog.Any("err", err))
+		}
+	}
+
+	if len(errs) == 0 {
+		if err := r.store.MarkPublished(ctx, event.ID); err != nil {
+			r.log.Error("failed to mark outbox event published", slog.Int64("event_id", event.ID), slog.Any("err", err))
+		}
+		return
+	}
+
+	attempt := event.Attempts + 1
+	dead := attempt >= r.cfg.MaxAttempts
+	if dead {
+		r.log.Error("outbox event moved to dead letters", slog.Int64("event_id", event.ID), slog.Int("attempts", attempt))
+	}
+
+	next := time.Now().Add(r.backoff(attempt))
+	if err := r.store.MarkFailed(ctx, event.ID, next, dead, errors.Join(errs...).Error()); err != nil {
+		r.log.Error("failed to mark outbox event failed", slog.Int64("event_id", event.ID), slog.Any("err", err))
+	}
+}
+
+// release возвращает в очередь забранные, но не отправленные события
+func (r *Relay) release(events []domain.OutboxEvent) {
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := r.store.Release(ctx, ids); err != nil {
+		r.log.Warn("failed to release outbox events", slog.Int("count", len(ids)), slog.Any("err", err))
+	}
+}
+
+// backoff — base * 2^(attempt-1), но не больше max
+func (r *Relay) backoff(attempt int) time.Duration {
+	delay := r.cfg.BackoffBase
+	for i := 1; i < attempt && delay < r.cfg.BackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, r.cfg.BackoffMax)
+}
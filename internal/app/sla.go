@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type SLAService interface {
+	StaleReviews(ctx context.Context, assignedBefore time.Time) ([]domain.StaleReview, error)
+	RemindReviewer(ctx context.Context, prId, reviewerId string, at time.Time) error
+	PullRequestEscalate(ctx context.Context, prId, reviewerId string) (*domain.PullRequest, string, error)
+}
+
+// SLAScheduler периодически ищет неначатые ревью открытых PR: после RemindAfter
+// напоминает ревьюверу (повторно — не чаще RemindEvery), после EscalateAfter переназначает ревью
+type SLAScheduler struct {
+	log *slog.Logger
+	svc SLAService
+	cfg config.SLA
+	now func() time.Time
+}
+
+func NewSLAScheduler(log *slog.Logger, svc SLAService, cfg config.SLA) (*SLAScheduler, error) {
+	if cfg.CheckInterval <= 0 {
+		return nil, fmt.Errorf("sla check interval must be positive")
+	}
+	if cfg.RemindAfter <= 0 && cfg.EscalateAfter <= 0 {
+		return nil, fmt.Errorf("sla requires remind_after or escalate_after")
+	}
+	if cfg.RemindAfter > 0 && cfg.RemindEvery <= 0 {
+		return nil, fmt.Errorf("sla remind_every must be positive")
+	}
+	if cfg.RemindAfter > 0 && cfg.EscalateAfter > 0 && cfg.EscalateAfter <= cfg.RemindAfter {
+		return nil, fmt.Errorf("sla escalate_after must be greater than remind_after")
+	}
+
+	return &SLAScheduler{log: log, svc: svc, cfg: cfg, now: time.Now}, nil
+}
+
+// Run блокируется до отмены ctx
+func (s *SLAScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SLAScheduler) check(ctx context.Context) {
+	now := s.now()
+
+	threshold := s.cfg.RemindAfter
+	if threshold <= 0 {
+		threshold = s.cfg.EscalateAfter
+	}
+	reviews, err := s.svc.StaleReviews(ctx, now.Add(-threshold))
+	if err != nil {
+		s.log.Error("failed to list stale reviews", slog.Any("err", err))
+		return
+	}
+
+	reminded, escalated := 0, 0
+	for _, review := range reviews {
+		if ctx.Err() != nil {
+			return
+		}
+
+		waiting := now.Sub(review.AssignedAt)
+		switch {
+		case s.cfg.EscalateAfter > 0 && waiting >= s.cfg.EscalateAfter:
+			if s.escalate(ctx, review, waiting) {
+				escalated++
+			}
+		case s.cfg.RemindAfter > 0 && (review.RemindedAt == nil || now.Sub(*review.RemindedAt) >= s.cfg.RemindEvery):
+			if err := s.svc.RemindReviewer(ctx, review.PullRequestID, review.ReviewerID, now); err != nil {
+				s.log.Error("failed to remind reviewer", slog.String("pr_id", review.PullRequestID), slog.String("user_id", review.ReviewerID), slog.Any("err", err))
+				continue
+			}
+			reminded++
+		}
+	}
+
+	if reminded > 0 || escalated > 0 {
+		s.log.Info("sla check finished", slog.Int("reminded", reminded), slog.Int("escalated", escalated))
+	}
+}
+
+// escalate переназначает ревью; если заменить некем, ревьювер остаётся и попытка повторится на следующей проверке
+func (s *SLAScheduler) escalate(ctx context.Context, review domain.StaleReview, waiting time.Duration) bool {
+	_, newReviewer, err := s.svc.PullRequestEscalate(ctx, review.PullRequestID, review.ReviewerID)
+	if err != nil {
+		s.log.Warn("failed to escalate stale review",
+			slog.String("pr_id", review.PullRequestID),
+			slog.String("user_id", review.ReviewerID),
+			slog.Duration("waiting", waiting),
+			slog.Any("err", err),
+		)
+		return false
+	}
+
+	s.log.Info("stale review escalated",
+		slog.String("pr_id", review.PullRequestID),
+		slog.String("old_user", review.ReviewerID),
+		slog.String("new_user", newReviewer),
+		slog.Duration("waiting", waiting),
+	)
+	return true
+}
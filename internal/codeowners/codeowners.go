@@ -0,0 +1,144 @@
+// Package codeowners разбирает правила владения кодом в формате CODEOWNERS
+// и находит владельцев для изменённых путей.
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// Parse читает правила в формате CODEOWNERS:
+//
+//	# комментарий
+//	*.go        @u1
+//	/billing/   @u2 @org/backend
+//
+// Владелец @user_id — пользователь, @org/team_name — команда.
+func Parse(r io.Reader) ([]domain.CodeOwnerRule, error) {
+	rules := make([]domain.CodeOwnerRule, 0)
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		rule := domain.CodeOwnerRule{Pattern: fields[0]}
+		if err := validatePattern(rule.Pattern); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", domain.ErrInvalidCodeOwners, line, err)
+		}
+
+		for _, owner := range fields[1:] {
+			name, ok := strings.CutPrefix(owner, "@")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("%w: line %d: owner %q must start with @", domain.ErrInvalidCodeOwners, line, owner)
+			}
+			if _, team, isTeam := strings.Cut(name, "/"); isTeam {
+				if team == "" {
+					return nil, fmt.Errorf("%w: line %d: empty team name in %q", domain.ErrInvalidCodeOwners, line, owner)
+				}
+				rule.Teams = append(rule.Teams, team)
+				continue
+			}
+			rule.Users = append(rule.Users, name)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Owners возвращает правило, которое определяет владельцев пути.
+// Как и в CODEOWNERS, побеждает последнее подходящее правило.
+func Owners(rules []domain.CodeOwnerRule, filePath string) (domain.CodeOwnerRule, bool) {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if Match(rules[i].Pattern, filePath) {
+			return rules[i], true
+		}
+	}
+	return domain.CodeOwnerRule{}, false
+}
+
+// Match сообщает, подходит ли путь под шаблон. Поддерживаются *, ?, [...] внутри
+// сегмента и ** для любого числа сегментов. Шаблон без / в начале или середине
+// ищется на любой глубине, шаблон каталога захватывает всё его содержимое.
+// Шаблон с / в конце подходит только каталогу, но не файлу с тем же именем.
+func Match(pattern, filePath string) bool {
+	filePath = strings.TrimPrefix(filePath, "/")
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.TrimSuffix(pattern, "/")
+	if !strings.Contains(trimmed, "/") {
+		trimmed = "**/" + trimmed
+	}
+	trimmed = strings.TrimPrefix(trimmed, "/")
+
+	pSegs := strings.Split(trimmed, "/")
+	sSegs := strings.Split(filePath, "/")
+
+	if dirOnly {
+		return matchSegments(append(pSegs, "*", "**"), sSegs)
+	}
+	if matchSegments(pSegs, sSegs) {
+		return true
+	}
+	// docs/* — только файлы непосредственно в docs, без вложенных каталогов
+	if pSegs[len(pSegs)-1] == "*" {
+		return false
+	}
+	return matchSegments(append(pSegs, "**"), sSegs)
+}
+
+func matchSegments(pattern, segs []string) bool {
+	if len(pattern) == 0 {
+		return len(segs) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segs); i++ {
+			if matchSegments(pattern[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segs) == 0 {
+		return false
+	}
+	ok, err := path.Match(pattern[0], segs[0])
+	if err != nil || !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], segs[1:])
+}
+
+func validatePattern(pattern string) error {
+	if strings.HasPrefix(pattern, "!") {
+		return fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+	for _, seg := range strings.Split(strings.Trim(pattern, "/"), "/") {
+		if seg == "**" {
+			continue
+		}
+		if _, err := path.Match(seg, ""); err != nil {
+			return fmt.Errorf("bad pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
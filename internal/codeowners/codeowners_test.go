@@ -0,0 +1,168 @@
+package codeowners
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		// шаблон без / ищется на любой глубине
+		{"*.go", "main.go", true},
+		{"*.go", "internal/service/service.go", true},
+		{"*.go", "main.gox", false},
+		{"Makefile", "tools/Makefile", true},
+
+		// / в начале привязывает шаблон к корню
+		{"/README.md", "README.md", true},
+		{"/README.md", "docs/README.md", false},
+		{"/build/", "build/out/app", true},
+		{"/build/", "src/build/app", false},
+
+		// / в середине тоже привязывает к корню
+		{"docs/guide.md", "docs/guide.md", true},
+		{"docs/guide.md", "site/docs/guide.md", false},
+
+		// каталог с / в конце захватывает всё содержимое на любой глубине
+		{"apps/", "apps/web/index.ts", true},
+		{"apps/", "services/apps/api/main.go", true},
+		{"apps/", "apps", false},
+		// каталог без / в конце тоже захватывает содержимое
+		{"/billing", "billing/invoice.go", true},
+
+		// docs/* — только файлы непосредственно в docs
+		{"docs/*", "docs/guide.md", true},
+		{"docs/*", "docs/api/v1.md", false},
+
+		// ** — любое число сегментов, включая ноль
+		{"**/logs", "logs", true},
+		{"**/logs", "deploy/k8s/logs", true},
+		{"**/logs", "deploy/logs/app.log", true},
+		{"docs/**/*.md", "docs/guide.md", true},
+		{"docs/**/*.md", "docs/api/v1/ref.md", true},
+		{"docs/**/*.md", "docs/api/v1/ref.txt", false},
+		{"/src/**", "src/a/b/c.go", true},
+
+		// ? и классы символов внутри сегмента
+		{"v?.go", "v1.go", true},
+		{"v?.go", "v10.go", false},
+		{"[ab].go", "b.go", true},
+		{"[ab].go", "c.go", false},
+
+		// * не пересекает границу сегмента
+		{"/internal/*.go", "internal/app.go", true},
+		{"/internal/*.go", "internal/app/app.go", false},
+
+		// ведущий / в пути изменения игнорируется
+		{"/README.md", "/README.md", true},
+	}
+
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	input := `
+# владельцы по умолчанию
+*           @u1
+
+/billing/   @u2 @org/backend   # платежи
+docs/*      @org/docs
+`
+	rules, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := []domain.CodeOwnerRule{
+		{Pattern: "*", Users: []string{"u1"}},
+		{Pattern: "/billing/", Users: []string{"u2"}, Teams: []string{"backend"}},
+		{Pattern: "docs/*", Teams: []string{"docs"}},
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("rules = %+v", rules)
+	}
+	for i := range want {
+		if rules[i].Pattern != want[i].Pattern || !slices.Equal(rules[i].Users, want[i].Users) || !slices.Equal(rules[i].Teams, want[i].Teams) {
+			t.Errorf("rule %d = %+v, want %+v", i, rules[i], want[i])
+		}
+	}
+}
+
+func TestParseWithoutOwners(t *testing.T) {
+	// правило без владельцев снимает владение с путей, как в CODEOWNERS
+	rules, err := Parse(strings.NewReader("/vendor/\n"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(rules) != 1 || len(rules[0].Users) != 0 || len(rules[0].Teams) != 0 {
+		t.Errorf("rules = %+v", rules)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"negated pattern":   "!*.go @u1",
+		"bad class":         "[a-.go @u1",
+		"owner without @":   "*.go u1",
+		"empty owner":       "*.go @",
+		"empty team":        "*.go @org/",
+		"error on 3rd line": "# header\n*.go @u1\n*.md docs",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(input))
+			if !errors.Is(err, domain.ErrInvalidCodeOwners) {
+				t.Fatalf("err = %v, want ErrInvalidCodeOwners", err)
+			}
+		})
+	}
+
+	_, err := Parse(strings.NewReader("# header\n*.go @u1\n*.md docs"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("err = %v, want line number 3", err)
+	}
+}
+
+func TestOwnersLastMatchWins(t *testing.T) {
+	rules := []domain.CodeOwnerRule{
+		{Pattern: "*", Users: []string{"u1"}},
+		{Pattern: "*.go", Users: []string{"u2"}},
+		{Pattern: "/billing/", Teams: []string{"payments"}},
+		{Pattern: "/billing/legacy/", Users: []string{}},
+	}
+
+	tests := []struct {
+		path    string
+		pattern string
+	}{
+		{"README.md", "*"},
+		{"cmd/main.go", "*.go"},
+		// /billing/ объявлен после *.go и перекрывает его
+		{"billing/invoice.go", "/billing/"},
+		// правило без владельцев тоже побеждает, если оно последнее
+		{"billing/legacy/old.go", "/billing/legacy/"},
+	}
+	for _, tt := range tests {
+		rule, ok := Owners(rules, tt.path)
+		if !ok || rule.Pattern != tt.pattern {
+			t.Errorf("Owners(%q) = %q, %v; want %q", tt.path, rule.Pattern, ok, tt.pattern)
+		}
+	}
+
+	if _, ok := Owners(rules[1:3], "README.md"); ok {
+		t.Error("Owners(README.md) matched without a catch-all rule")
+	}
+}
@@ -10,10 +10,16 @@ import (
 )
 
 type Config struct {
-	App        App        `yaml:"app"`
-	Database   Database   `yaml:"database"`
-	PR         PR         `yaml:"pr"`
-	Migrations Migrations `yaml:"migrations"`
+	App           App           `yaml:"app"`
+	Database      Database      `yaml:"database"`
+	PR            PR            `yaml:"pr"`
+	Migrations    Migrations    `yaml:"migrations"`
+	Outbox        Outbox        `yaml:"outbox"`
+	Integrations  Integrations  `yaml:"integrations"`
+	ReviewerSync  ReviewerSync  `yaml:"reviewer_sync"`
+	Notifications Notifications `yaml:"notifications"`
+	SLA           SLA           `yaml:"sla"`
+	Fairness      Fairness      `yaml:"fairness"`
 }
 
 type App struct {
@@ -35,8 +41,137 @@ type Database struct {
 }
 
 type PR struct {
-	MaxReviewers     int  `yaml:"max_reviewers" env:"PR_MAX_REVIEWERS" env-default:"2"`
-	AssignOnlyActive bool `yaml:"assign_only_active_users" env:"PR_ASSIGN_ONLY_ACTIVE" env-default:"true"`
+	MaxReviewers     int            `yaml:"max_reviewers" env:"PR_MAX_REVIEWERS" env-default:"2"`
+	AssignOnlyActive bool           `yaml:"assign_only_active_users" env:"PR_ASSIGN_ONLY_ACTIVE" env-default:"true"`
+	Strategy         string         `yaml:"strategy" env:"PR_STRATEGY" env-default:"least_loaded"`
+	Weights          map[string]int `yaml:"weights"`
+	Seed             int64          `yaml:"seed" env:"PR_SEED"`
+	HashPick         bool           `yaml:"hash_pick" env:"PR_HASH_PICK"`
+	DefaultCapacity  int            `yaml:"default_capacity" env:"PR_DEFAULT_CAPACITY" env-default:"0"`
+	Merge            MergeRules     `yaml:"merge"`
+}
+
+type MergeRules struct {
+	RequiredApprovals       int      `yaml:"required_approvals" env:"PR_MERGE_REQUIRED_APPROVALS" env-default:"0"`
+	BlockOnChangesRequested bool     `yaml:"block_on_changes_requested" env:"PR_MERGE_BLOCK_ON_CHANGES_REQUESTED" env-default:"true"`
+	Admins                  []string `yaml:"admins" env:"PR_MERGE_ADMINS" env-separator:","`
+}
+
+type Outbox struct {
+	Enabled      bool          `yaml:"enabled" env:"OUTBOX_ENABLED" env-default:"false"`
+	PollInterval time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL" env-default:"1s"`
+	BatchSize    int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	Lease        time.Duration `yaml:"lease" env:"OUTBOX_LEASE" env-default:"30s"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	BackoffBase  time.Duration `yaml:"backoff_base" env:"OUTBOX_BACKOFF_BASE" env-default:"1s"`
+	BackoffMax   time.Duration `yaml:"backoff_max" env:"OUTBOX_BACKOFF_MAX" env-default:"5m"`
+	Webhook      OutboxWebhook `yaml:"webhook"`
+	LogFile      OutboxLogFile `yaml:"log_file"`
+}
+
+type OutboxWebhook struct {
+	URL     string        `yaml:"url" env:"OUTBOX_WEBHOOK_URL"`
+	Timeout time.Duration `yaml:"timeout" env:"OUTBOX_WEBHOOK_TIMEOUT" env-default:"5s"`
+}
+
+type OutboxLogFile struct {
+	Path string `yaml:"path" env:"OUTBOX_LOG_FILE"`
+}
+
+type Integrations struct {
+	GitHub Integration `yaml:"github" env-prefix:"GITHUB_"`
+	GitLab Integration `yaml:"gitlab" env-prefix:"GITLAB_"`
+	Gitea  Integration `yaml:"gitea" env-prefix:"GITEA_"`
+}
+
+// Integration — приём вебхуков одного Git-хостинга
+type Integration struct {
+	Enabled bool              `yaml:"enabled" env:"ENABLED" env-default:"false"`
+	Secret  string            `yaml:"secret" env:"WEBHOOK_SECRET"`
+	Users   map[string]string `yaml:"users"`
+}
+
+type ReviewerSync struct {
+	GitHub GitHubSync `yaml:"github"`
+}
+
+type GitHubSync struct {
+	Enabled bool          `yaml:"enabled" env:"GITHUB_SYNC_ENABLED" env-default:"false"`
+	APIURL  string        `yaml:"api_url" env:"GITHUB_API_URL" env-default:"https://api.github.com"`
+	Token   string        `yaml:"token" env:"GITHUB_TOKEN"`
+	Timeout time.Duration `yaml:"timeout" env:"GITHUB_API_TIMEOUT" env-default:"10s"`
+	Repos   []string      `yaml:"repos" env:"GITHUB_SYNC_REPOS" env-separator:","`
+}
+
+type Notifications struct {
+	Chat ChatNotifications `yaml:"chat"`
+	SMTP SMTPNotifications `yaml:"smtp"`
+}
+
+type ChatNotifications struct {
+	Enabled    bool              `yaml:"enabled" env:"CHAT_ENABLED" env-default:"false"`
+	DefaultURL string            `yaml:"default_url" env:"CHAT_WEBHOOK_URL"`
+	TeamURLs   map[string]string `yaml:"team_urls"`
+	Username   string            `yaml:"username" env:"CHAT_USERNAME"`
+	Timeout    time.Duration     `yaml:"timeout" env:"CHAT_TIMEOUT" env-default:"5s"`
+	Templates  ChatTemplates     `yaml:"templates"`
+}
+
+// ChatTemplates — text/template для сообщений; пустой шаблон заменяется встроенным
+type ChatTemplates struct {
+	Assigned   string `yaml:"assigned"`
+	Unassigned string `yaml:"unassigned"`
+	Merged     string `yaml:"merged"`
+	Reminder   string `yaml:"reminder"`
+}
+
+type SMTPNotifications struct {
+	Enabled   bool           `yaml:"enabled" env:"SMTP_ENABLED" env-default:"false"`
+	Host      string         `yaml:"host" env:"SMTP_HOST"`
+	Port      int            `yaml:"port" env:"SMTP_PORT" env-default:"25"`
+	Username  string         `yaml:"username" env:"SMTP_USERNAME"`
+	Password  string         `yaml:"password" env:"SMTP_PASSWORD"`
+	From      string         `yaml:"from" env:"SMTP_FROM"`
+	Timeout   time.Duration  `yaml:"timeout" env:"SMTP_TIMEOUT" env-default:"10s"`
+	Templates EmailTemplates `yaml:"templates"`
+	Digest    EmailDigest    `yaml:"digest"`
+}
+
+// EmailTemplates — text/template письма о назначении, поля как у шаблонов чата
+type EmailTemplates struct {
+	Subject         string `yaml:"subject"`
+	Body            string `yaml:"body"`
+	ReminderSubject string `yaml:"reminder_subject"`
+	ReminderBody    string `yaml:"reminder_body"`
+}
+
+type EmailDigest struct {
+	Enabled  bool   `yaml:"enabled" env:"SMTP_DIGEST_ENABLED" env-default:"false"`
+	At       string `yaml:"at" env:"SMTP_DIGEST_AT" env-default:"09:00"`
+	Timezone string `yaml:"timezone" env:"SMTP_DIGEST_TIMEZONE"`
+}
+
+// SLA — напоминания о неначатых ревью и автоматическое переназначение.
+// Нулевой порог отключает соответствующее действие.
+type SLA struct {
+	Enabled       bool          `yaml:"enabled" env:"SLA_ENABLED" env-default:"false"`
+	CheckInterval time.Duration `yaml:"check_interval" env:"SLA_CHECK_INTERVAL" env-default:"15m"`
+	RemindAfter   time.Duration `yaml:"remind_after" env:"SLA_REMIND_AFTER" env-default:"24h"`
+	RemindEvery   time.Duration `yaml:"remind_every" env:"SLA_REMIND_EVERY" env-default:"24h"`
+	EscalateAfter time.Duration `yaml:"escalate_after" env:"SLA_ESCALATE_AFTER" env-default:"72h"`
+}
+
+// Fairness — пороги отчёта /stats/fairness и фоновая проверка перекоса назначений
+type Fairness struct {
+	GiniThreshold float64         `yaml:"gini_threshold" env:"FAIRNESS_GINI_THRESHOLD" env-default:"0.3"`
+	Tolerance     float64         `yaml:"tolerance" env:"FAIRNESS_TOLERANCE" env-default:"0.5"`
+	Monitor       FairnessMonitor `yaml:"monitor"`
+}
+
+type FairnessMonitor struct {
+	Enabled       bool          `yaml:"enabled" env:"FAIRNESS_MONITOR_ENABLED" env-default:"false"`
+	CheckInterval time.Duration `yaml:"check_interval" env:"FAIRNESS_MONITOR_CHECK_INTERVAL" env-default:"1h"`
+	Window        time.Duration `yaml:"window" env:"FAIRNESS_MONITOR_WINDOW" env-default:"720h"`
 }
 
 type Migrations struct {
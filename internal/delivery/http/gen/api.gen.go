@@ -15,28 +15,134 @@ import (
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
 )
 
+// Defines values for AssignmentEventEventType.
+const (
+	AssignmentEventEventTypeASSIGNED   AssignmentEventEventType = "ASSIGNED"
+	AssignmentEventEventTypeREASSIGNED AssignmentEventEventType = "REASSIGNED"
+	AssignmentEventEventTypeUNASSIGNED AssignmentEventEventType = "UNASSIGNED"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	ErrorResponseErrorCodeNOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	ErrorResponseErrorCodeNOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	ErrorResponseErrorCodeNOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	ErrorResponseErrorCodePREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	ErrorResponseErrorCodePRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	ErrorResponseErrorCodeTEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeCAPACITYEXHAUSTED  ErrorResponseErrorCode = "CAPACITY_EXHAUSTED"
+	ErrorResponseErrorCodeFORBIDDEN          ErrorResponseErrorCode = "FORBIDDEN"
+	ErrorResponseErrorCodeINVALIDCAPACITY    ErrorResponseErrorCode = "INVALID_CAPACITY"
+	ErrorResponseErrorCodeINVALIDCODEOWNERS  ErrorResponseErrorCode = "INVALID_CODEOWNERS"
+	ErrorResponseErrorCodeINVALIDCURSOR      ErrorResponseErrorCode = "INVALID_CURSOR"
+	ErrorResponseErrorCodeINVALIDEMAIL       ErrorResponseErrorCode = "INVALID_EMAIL"
+	ErrorResponseErrorCodeINVALIDFALLBACK    ErrorResponseErrorCode = "INVALID_FALLBACK"
+	ErrorResponseErrorCodeINVALIDFILTER      ErrorResponseErrorCode = "INVALID_FILTER"
+	ErrorResponseErrorCodeINVALIDPERIOD      ErrorResponseErrorCode = "INVALID_PERIOD"
+	ErrorResponseErrorCodeINVALIDPOLICY      ErrorResponseErrorCode = "INVALID_POLICY"
+	ErrorResponseErrorCodeINVALIDREVIEWSTATE ErrorResponseErrorCode = "INVALID_REVIEW_STATE"
+	ErrorResponseErrorCodeINVALIDSIGNATURE   ErrorResponseErrorCode = "INVALID_SIGNATURE"
+	ErrorResponseErrorCodeINVALIDTAG         ErrorResponseErrorCode = "INVALID_TAG"
+	ErrorResponseErrorCodeINVALIDTRANSITION  ErrorResponseErrorCode = "INVALID_TRANSITION"
+	ErrorResponseErrorCodeINVALIDWEBHOOK     ErrorResponseErrorCode = "INVALID_WEBHOOK"
+	ErrorResponseErrorCodeNOCANDIDATE        ErrorResponseErrorCode = "NO_CANDIDATE"
+	ErrorResponseErrorCodeNOTAPPROVED        ErrorResponseErrorCode = "NOT_APPROVED"
+	ErrorResponseErrorCodeNOTASSIGNED        ErrorResponseErrorCode = "NOT_ASSIGNED"
+	ErrorResponseErrorCodeNOTFOUND           ErrorResponseErrorCode = "NOT_FOUND"
+	ErrorResponseErrorCodePREXISTS           ErrorResponseErrorCode = "PR_EXISTS"
+	ErrorResponseErrorCodePRMERGED           ErrorResponseErrorCode = "PR_MERGED"
+	ErrorResponseErrorCodeTAGSNOTCOVERED     ErrorResponseErrorCode = "TAGS_NOT_COVERED"
+	ErrorResponseErrorCodeTEAMEXISTS         ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeUNKNOWNUSER        ErrorResponseErrorCode = "UNKNOWN_USER"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewState.
+const (
+	ReviewStateAPPROVED         ReviewState = "APPROVED"
+	ReviewStateCHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCOMMENTED        ReviewState = "COMMENTED"
+	ReviewStatePENDING          ReviewState = "PENDING"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED  WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCESS WebhookDeliveryStatus = "SUCCESS"
+)
+
+// Defines values for WebhookEvent.
+const (
+	WebhookEventPullRequestCreated          WebhookEvent = "pull_request.created"
+	WebhookEventPullRequestMerged           WebhookEvent = "pull_request.merged"
+	WebhookEventPullRequestReassigned       WebhookEvent = "pull_request.reassigned"
+	WebhookEventPullRequestReviewReminder   WebhookEvent = "pull_request.review_reminder"
+	WebhookEventPullRequestReviewersChanged WebhookEvent = "pull_request.reviewers_changed"
+	WebhookEventPullRequestStatusChanged    WebhookEvent = "pull_request.status_changed"
+	WebhookEventTeamAssignmentSkew          WebhookEvent = "team.assignment_skew"
+)
+
+// Defines values for GetStatsParamsStatus.
+const (
+	GetStatsParamsStatusCLOSED GetStatsParamsStatus = "CLOSED"
+	GetStatsParamsStatusDRAFT  GetStatsParamsStatus = "DRAFT"
+	GetStatsParamsStatusMERGED GetStatsParamsStatus = "MERGED"
+	GetStatsParamsStatusOPEN   GetStatsParamsStatus = "OPEN"
+)
+
+// Defines values for GetUsersGetReviewParamsStatus.
+const (
+	GetUsersGetReviewParamsStatusCLOSED GetUsersGetReviewParamsStatus = "CLOSED"
+	GetUsersGetReviewParamsStatusDRAFT  GetUsersGetReviewParamsStatus = "DRAFT"
+	GetUsersGetReviewParamsStatusMERGED GetUsersGetReviewParamsStatus = "MERGED"
+	GetUsersGetReviewParamsStatusOPEN   GetUsersGetReviewParamsStatus = "OPEN"
+)
+
+// Defines values for GetUsersGetReviewParamsOrder.
+const (
+	GetUsersGetReviewParamsOrderAsc  GetUsersGetReviewParamsOrder = "asc"
+	GetUsersGetReviewParamsOrderDesc GetUsersGetReviewParamsOrder = "desc"
+)
+
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	// ActorId Инициатор изменения; null — системное действие
+	ActorId       *string                  `json:"actor_id"`
+	CreatedAt     time.Time                `json:"created_at"`
+	EventType     AssignmentEventEventType `json:"event_type"`
+	Id            int64                    `json:"id"`
+	NewReviewerId *string                  `json:"new_reviewer_id"`
+	OldReviewerId *string                  `json:"old_reviewer_id"`
+	PullRequestId string                   `json:"pull_request_id"`
+
+	// Reason Причина (PR_CREATED, MANUAL_REASSIGN, TEAM_DEACTIVATED, USER_UNAVAILABLE, SLA_ESCALATED, READY_FOR_REVIEW, REOPENED, CLOSED)
+	Reason string `json:"reason"`
+
+	// Strategy Стратегия выбора ревьюверов, действовавшая в момент изменения
+	Strategy string `json:"strategy"`
+}
+
+// AssignmentEventEventType defines model for AssignmentEvent.EventType.
+type AssignmentEventEventType string
+
+// CodeOwnerRule defines model for CodeOwnerRule.
+type CodeOwnerRule struct {
+	// Pattern Шаблон пути в синтаксисе CODEOWNERS
+	Pattern string   `json:"pattern"`
+	Teams   []string `json:"teams"`
+	Users   []string `json:"users"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -48,15 +154,33 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// LatencySummary defines model for LatencySummary.
+type LatencySummary struct {
+	// Count Число наблюдений в окне
+	Count         int      `json:"count"`
+	MedianSeconds *float64 `json:"median_seconds"`
+	P90Seconds    *float64 `json:"p90_seconds"`
+}
+
+// MemberAssignments defines model for MemberAssignments.
+type MemberAssignments struct {
+	AssignmentsCount int    `json:"assignments_count"`
+	UserId           string `json:"user_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	// AssignedReviewers user_id назначенных ревьюверов (0..max_reviewers политики команды)
 	AssignedReviewers []string          `json:"assigned_reviewers"`
 	AuthorId          string            `json:"author_id"`
+	ChangedPaths      *[]string         `json:"changed_paths,omitempty"`
+	ClosedAt          *time.Time        `json:"closedAt"`
 	CreatedAt         *time.Time        `json:"createdAt"`
 	MergedAt          *time.Time        `json:"mergedAt"`
 	PullRequestId     string            `json:"pull_request_id"`
 	PullRequestName   string            `json:"pull_request_name"`
+	RequiredTags      *[]string         `json:"required_tags,omitempty"`
+	Reviewers         []Reviewer        `json:"reviewers"`
 	Status            PullRequestStatus `json:"status"`
 }
 
@@ -66,6 +190,7 @@ type PullRequestStatus string
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
+	CreatedAt       *time.Time             `json:"created_at,omitempty"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
 	Status          PullRequestShortStatus `json:"status"`
@@ -74,49 +199,293 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewState defines model for ReviewState.
+type ReviewState string
+
+// Reviewer defines model for Reviewer.
+type Reviewer struct {
+	// AssignedAt Время назначения ревьювера на PR
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// RespondedAt Время первого вердикта ревьювера
+	RespondedAt *time.Time  `json:"responded_at"`
+	State       ReviewState `json:"state"`
+
+	// StateUpdatedAt Время последнего вердикта ревьювера
+	StateUpdatedAt *time.Time `json:"state_updated_at"`
+
+	// TeamName Команда, из которой выбран ревьювер (домашняя или резервная)
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	AssignmentsCount int `json:"assignments_count"`
+
+	// Gini Коэффициент Джини (0 — поровну)
+	Gini float64 `json:"gini"`
+
+	// MaxMinRatio Отношение максимума к минимуму; null, если кому-то не досталось ни одного ревью
+	MaxMinRatio *float64 `json:"max_min_ratio"`
+
+	// Mean Среднее число назначений на участника
+	Mean float64 `json:"mean"`
+
+	// MembersCount Активные участники команды
+	MembersCount int `json:"members_count"`
+
+	// OverAssigned Назначений больше среднего сверх допуска
+	OverAssigned []MemberAssignments `json:"over_assigned"`
+	Skewed       bool                `json:"skewed"`
+	TeamName     string              `json:"team_name"`
+
+	// Threshold Порог Gini, выше которого распределение считается перекошенным
+	Threshold float64 `json:"threshold"`
+
+	// UnderAssigned Назначений меньше среднего сверх допуска
+	UnderAssigned []MemberAssignments `json:"under_assigned"`
+}
+
+// TeamFallbacks defines model for TeamFallbacks.
+type TeamFallbacks struct {
+	// FallbackTeams Резервные команды в порядке перебора
+	FallbackTeams []string `json:"fallback_teams"`
+	TeamName      string   `json:"team_name"`
+}
+
+// TeamLatency defines model for TeamLatency.
+type TeamLatency struct {
+	FirstReview LatencySummary `json:"first_review"`
+	Merge       LatencySummary `json:"merge"`
+	TeamName    string         `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	// Email Адрес для уведомлений; если не передать, текущий не меняется
+	Email    *string `json:"email,omitempty"`
+	IsActive bool    `json:"is_active"`
+
+	// Tags Навыки участника; если не передать, текущие навыки не меняются
+	Tags     *[]string `json:"tags,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// TeamPolicy defines model for TeamPolicy.
+type TeamPolicy struct {
+	// AssignOnlyActive Назначать только активных участников команды
+	AssignOnlyActive bool `json:"assign_only_active"`
+
+	// DefaultCapacity Лимит открытых ревью для участников без личного лимита, 0 — без ограничения
+	DefaultCapacity *int `json:"default_capacity,omitempty"`
+
+	// MaxReviewers Максимальное число автоматически назначаемых ревьюверов
+	MaxReviewers int `json:"max_reviewers"`
+
+	// MinReviewers Минимальное число ревьюверов, без которого PR не создаётся
+	MinReviewers int    `json:"min_reviewers"`
+	TeamName     string `json:"team_name"`
+}
+
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	EndsAt time.Time `json:"ends_at"`
+	Id     int64     `json:"id"`
+
+	// Reason Причина отсутствия (отпуск, out-of-office)
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	// Email Адрес для уведомлений; null — письма не отправляются
+	Email    *string `json:"email"`
+	IsActive bool    `json:"is_active"`
+
+	// MaxOpenReviews Личный лимит открытых ревью (положительный); null — действует лимит команды
+	MaxOpenReviews *int      `json:"max_open_reviews"`
+	Tags           *[]string `json:"tags,omitempty"`
+	TeamName       string    `json:"team_name"`
+	UserId         string    `json:"user_id"`
+	Username       string    `json:"username"`
+}
+
+// UserLatency defines model for UserLatency.
+type UserLatency struct {
+	FirstReview LatencySummary `json:"first_review"`
+	Merge       LatencySummary `json:"merge"`
+	TeamName    string         `json:"team_name"`
+	UserId      string         `json:"user_id"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int          `json:"attempts"`
+	CreatedAt   time.Time    `json:"created_at"`
+	DeliveredAt *time.Time   `json:"delivered_at"`
+	Error       *string      `json:"error"`
+	EventId     int64        `json:"event_id"`
+	EventType   WebhookEvent `json:"event_type"`
+	Id          int64        `json:"id"`
+
+	// ResponseCode HTTP-код ответа подписчика; null — ответа не было
+	ResponseCode   *int                  `json:"response_code"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Events События подписки; пустой список — все события
+	Events []WebhookEvent `json:"events"`
+	Id     int64          `json:"id"`
+
+	// TeamName Только PR авторов из этой команды; null — все PR
+	TeamName *string `json:"team_name"`
+	Url      string  `json:"url"`
 }
 
+// StatsFromQuery defines model for StatsFromQuery.
+type StatsFromQuery = time.Time
+
+// StatsToQuery defines model for StatsToQuery.
+type StatsToQuery = time.Time
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostCodeownersSetJSONBody defines parameters for PostCodeownersSet.
+type PostCodeownersSetJSONBody struct {
+	// Content Текст файла CODEOWNERS; @user_id — пользователь, @org/team_name — команда
+	Content string `json:"content"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	// ActorId user_id инициатора, сохраняется в истории PR
+	ActorId       *string `json:"actor_id,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedPaths Изменённые файлы; их владельцы из CODEOWNERS назначаются в первую очередь
+	ChangedPaths *[]string `json:"changed_paths,omitempty"`
+
+	// Draft Создать черновик без ревьюверов (назначаются при /pullRequest/ready)
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// RequiredTags Навыки, каждый из которых должен быть хотя бы у одного ревьювера
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// ActorId user_id выполняющего merge
+	ActorId *string `json:"actor_id,omitempty"`
+
+	// Force Обойти правила одобрения (только для администраторов из конфига).
+	// actor_id не аутентифицируется, поэтому force — защита от случайного merge,
+	// а не граница безопасности: доступ к эндпоинту нужно ограничивать на уровне сети или прокси.
+	Force         *bool  `json:"force,omitempty"`
 	PullRequestId string `json:"pull_request_id"`
+
+	// Reason Причина экстренного merge, сохраняется в истории PR
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	// ActorId user_id инициатора, сохраняется в истории PR
+	ActorId       *string `json:"actor_id,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	// ActorId user_id инициатора переназначения (для журнала назначений)
+	ActorId       *string `json:"actor_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	// ActorId user_id инициатора, сохраняется в истории PR
+	ActorId       *string `json:"actor_id,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	PullRequestId string      `json:"pull_request_id"`
+	ReviewerId    string      `json:"reviewer_id"`
+	State         ReviewState `json:"state"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// From PR, созданные не раньше этого момента
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To PR, созданные раньше этого момента
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName *string               `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetStatsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetStatsParamsStatus defines parameters for GetStats.
+type GetStatsParamsStatus string
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// From Начало окна (включительно), по умолчанию to минус 30 дней
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To       *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+	TeamName *string       `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsLatencyParams defines parameters for GetStatsLatency.
+type GetStatsLatencyParams struct {
+	// From Начало окна (включительно), по умолчанию to минус 30 дней
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
 }
 
 // PostTeamDeactivateUsersJSONBody defines parameters for PostTeamDeactivateUsers.
@@ -130,10 +499,71 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamGetFallbacksParams defines parameters for GetTeamGetFallbacks.
+type GetTeamGetFallbacksParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	EndsAt time.Time `json:"ends_at"`
+	Reason *string   `json:"reason,omitempty"`
+
+	// ReassignOpenReviews Если период уже начался — сразу переназначить открытые ревью пользователя
+	ReassignOpenReviews *bool     `json:"reassign_open_reviews,omitempty"`
+	StartsAt            time.Time `json:"starts_at"`
+	UserId              string    `json:"user_id"`
+}
+
+// PostUsersDeleteUnavailabilityJSONBody defines parameters for PostUsersDeleteUnavailability.
+type PostUsersDeleteUnavailabilityJSONBody struct {
+	Id int64 `json:"id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// PendingOnly Вернуть только открытые PR без вердикта пользователя
+	PendingOnly *bool                          `form:"pending_only,omitempty" json:"pending_only,omitempty"`
+	Status      *GetUsersGetReviewParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Порядок по created_at (по умолчанию новые первыми)
+	Order *GetUsersGetReviewParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Размер страницы (по умолчанию 50, не больше 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа. Курсор привязан к пользователю, порядку и фильтрам;
+	// с другими значениями запрос отклоняется с INVALID_CURSOR.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUsersGetReviewParamsStatus defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsStatus string
+
+// GetUsersGetReviewParamsOrder defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsOrder string
+
+// GetUsersGetUnavailabilityParams defines parameters for GetUsersGetUnavailability.
+type GetUsersGetUnavailabilityParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersHistoryParams defines parameters for GetUsersHistory.
+type GetUsersHistoryParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSetEmailJSONBody defines parameters for PostUsersSetEmail.
+type PostUsersSetEmailJSONBody struct {
+	// Email Пустая строка удаляет адрес
+	Email  string `json:"email"`
+	UserId string `json:"user_id"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -142,38 +572,165 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	// MaxOpenReviews Положительный лимит; null — сбросить на лимит команды.
+	// 0 не принимается: чтобы не назначать пользователя, используйте setIsActive или addUnavailability.
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetTagsJSONBody defines parameters for PostUsersSetTags.
+type PostUsersSetTagsJSONBody struct {
+	Tags   []string `json:"tags"`
+	UserId string   `json:"user_id"`
+}
+
+// PostUsersUpdateUnavailabilityJSONBody defines parameters for PostUsersUpdateUnavailability.
+type PostUsersUpdateUnavailabilityJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// PostWebhooksCreateJSONBody defines parameters for PostWebhooksCreate.
+type PostWebhooksCreateJSONBody struct {
+	Events *[]WebhookEvent `json:"events,omitempty"`
+
+	// Secret Секрет для подписи; если не задан, будет сгенерирован
+	Secret *string `json:"secret,omitempty"`
+
+	// TeamName Ограничить подписку PR авторов из этой команды
+	TeamName *string `json:"team_name,omitempty"`
+	Url      string  `json:"url"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	SubscriptionId int64 `form:"subscription_id" json:"subscription_id"`
+
+	// Limit Сколько доставок вернуть (по умолчанию 50, не больше 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostWebhooksRedeliverJSONBody defines parameters for PostWebhooksRedeliver.
+type PostWebhooksRedeliverJSONBody struct {
+	DeliveryId int64 `json:"delivery_id"`
+}
+
+// PostCodeownersSetJSONRequestBody defines body for PostCodeownersSet for application/json ContentType.
+type PostCodeownersSetJSONRequestBody PostCodeownersSetJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
 // PostTeamDeactivateUsersJSONRequestBody defines body for PostTeamDeactivateUsers for application/json ContentType.
 type PostTeamDeactivateUsersJSONRequestBody PostTeamDeactivateUsersJSONBody
 
+// PostTeamSetFallbacksJSONRequestBody defines body for PostTeamSetFallbacks for application/json ContentType.
+type PostTeamSetFallbacksJSONRequestBody = TeamFallbacks
+
+// PostTeamSetPolicyJSONRequestBody defines body for PostTeamSetPolicy for application/json ContentType.
+type PostTeamSetPolicyJSONRequestBody = TeamPolicy
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersDeleteUnavailabilityJSONRequestBody defines body for PostUsersDeleteUnavailability for application/json ContentType.
+type PostUsersDeleteUnavailabilityJSONRequestBody PostUsersDeleteUnavailabilityJSONBody
+
+// PostUsersSetEmailJSONRequestBody defines body for PostUsersSetEmail for application/json ContentType.
+type PostUsersSetEmailJSONRequestBody PostUsersSetEmailJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetTagsJSONRequestBody defines body for PostUsersSetTags for application/json ContentType.
+type PostUsersSetTagsJSONRequestBody PostUsersSetTagsJSONBody
+
+// PostUsersUpdateUnavailabilityJSONRequestBody defines body for PostUsersUpdateUnavailability for application/json ContentType.
+type PostUsersUpdateUnavailabilityJSONRequestBody PostUsersUpdateUnavailabilityJSONBody
+
+// PostWebhooksCreateJSONRequestBody defines body for PostWebhooksCreate for application/json ContentType.
+type PostWebhooksCreateJSONRequestBody PostWebhooksCreateJSONBody
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
+// PostWebhooksRedeliverJSONRequestBody defines body for PostWebhooksRedeliver for application/json ContentType.
+type PostWebhooksRedeliverJSONRequestBody PostWebhooksRedeliverJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Получить текущие правила владения кодом
+	// (GET /codeowners/get)
+	GetCodeownersGet(c *gin.Context)
+	// Загрузить правила владения кодом в формате CODEOWNERS (заменяет текущие)
+	// (POST /codeowners/set)
+	PostCodeownersSet(c *gin.Context)
+	// Закрыть PR без merge и освободить ревьюверов (идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(c *gin.Context)
+	// Создать PR и автоматически назначить ревьюверов из команды автора согласно политике команды
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(c *gin.Context)
+	// Журнал назначений ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(c *gin.Context, params GetPullRequestHistoryParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(c *gin.Context)
-	// Переназначить конкретного ревьювера на другого из его команды
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(c *gin.Context)
+	// Переназначить конкретного ревьювера на другого из его команды (или резервных команд)
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(c *gin.Context)
-	// Получить статистику назначений по пользователям и PR
+	// Переоткрыть закрытый PR и заново назначить ревьюверов
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(c *gin.Context)
+	// Зафиксировать вердикт ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(c *gin.Context)
+	// Получить статистику назначений по пользователям, PR, командам и дням
 	// (GET /stats)
-	GetStats(c *gin.Context)
+	GetStats(c *gin.Context, params GetStatsParams)
+	// Равномерность назначений среди активных участников команд
+	// (GET /stats/fairness)
+	GetStatsFairness(c *gin.Context, params GetStatsFairnessParams)
+	// Медиана и p90 времени до первого ревью и до merge по пользователям и командам
+	// (GET /stats/latency)
+	GetStatsLatency(c *gin.Context, params GetStatsLatencyParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(c *gin.Context)
@@ -183,12 +740,60 @@ type ServerInterface interface {
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Получить цепочку резервных команд
+	// (GET /team/getFallbacks)
+	GetTeamGetFallbacks(c *gin.Context, params GetTeamGetFallbacksParams)
+	// Задать цепочку резервных команд для назначения ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(c *gin.Context)
+	// Задать политику назначения ревьюверов для команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(c *gin.Context)
+	// Добавить период недоступности пользователя (на это время он не назначается ревьювером)
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(c *gin.Context)
+	// Удалить период недоступности
+	// (POST /users/deleteUnavailability)
+	PostUsersDeleteUnavailability(c *gin.Context)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
+	// Получить периоды недоступности пользователя
+	// (GET /users/getUnavailability)
+	GetUsersGetUnavailability(c *gin.Context, params GetUsersGetUnavailabilityParams)
+	// События назначения и снятия пользователя с ревью
+	// (GET /users/history)
+	GetUsersHistory(c *gin.Context, params GetUsersHistoryParams)
+	// Задать email для уведомлений о назначениях и ежедневной сводки
+	// (POST /users/setEmail)
+	PostUsersSetEmail(c *gin.Context)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(c *gin.Context)
+	// Задать личный лимит одновременно открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(c *gin.Context)
+	// Задать навыки пользователя (заменяет текущие)
+	// (POST /users/setTags)
+	PostUsersSetTags(c *gin.Context)
+	// Изменить период недоступности
+	// (POST /users/updateUnavailability)
+	PostUsersUpdateUnavailability(c *gin.Context)
+	// Подписаться на события PR
+	// (POST /webhooks/create)
+	PostWebhooksCreate(c *gin.Context)
+	// Удалить подписку вместе с историей доставок
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(c *gin.Context)
+	// Последние доставки подписки (новые первыми)
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(c *gin.Context, params GetWebhooksDeliveriesParams)
+	// Получить подписки на события
+	// (GET /webhooks/list)
+	GetWebhooksList(c *gin.Context)
+	// Повторно отправить доставку
+	// (POST /webhooks/redeliver)
+	PostWebhooksRedeliver(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -200,34 +805,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PostPullRequestCreate(c)
-}
-
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PostPullRequestMerge(c)
-}
-
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+// GetCodeownersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetCodeownersGet(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -236,11 +815,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestReassign(c)
+	siw.Handler.GetCodeownersGet(c)
 }
 
-// GetStats operation middleware
-func (siw *ServerInterfaceWrapper) GetStats(c *gin.Context) {
+// PostCodeownersSet operation middleware
+func (siw *ServerInterfaceWrapper) PostCodeownersSet(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -249,11 +828,11 @@ func (siw *ServerInterfaceWrapper) GetStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStats(c)
+	siw.Handler.PostCodeownersSet(c)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -262,11 +841,11 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTeamAdd(c)
+	siw.Handler.PostPullRequestClose(c)
 }
 
-// PostTeamDeactivateUsers operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamDeactivateUsers(c *gin.Context) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -275,29 +854,29 @@ func (siw *ServerInterfaceWrapper) PostTeamDeactivateUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTeamDeactivateUsers(c)
+	siw.Handler.PostPullRequestCreate(c)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestHistoryParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := c.Query("team_name"); paramValue != "" {
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -308,31 +887,37 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamGet(c, params)
+	siw.Handler.GetPullRequestHistory(c, params)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
-
-	var err error
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Required query parameter "user_id" -------------
+	siw.Handler.PostPullRequestMerge(c)
+}
 
-	if paramValue := c.Query("user_id"); paramValue != "" {
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(c *gin.Context) {
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.PostPullRequestReady(c)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -341,11 +926,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersGetReview(c, params)
+	siw.Handler.PostPullRequestReassign(c)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -354,222 +939,1189 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUsersSetIsActive(c)
-}
-
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+	siw.Handler.PostPullRequestReopen(c)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(c *gin.Context) {
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
-
-	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	router.GET(options.BaseURL+"/stats", wrapper.GetStats)
-	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	router.POST(options.BaseURL+"/team/deactivateUsers", wrapper.PostTeamDeactivateUsers)
-	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	siw.Handler.PostPullRequestReview(c)
 }
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(c *gin.Context) {
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "from" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	// ------------- Optional query parameter "to" -------------
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "team_name" -------------
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Optional query parameter "status" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+	siw.Handler.GetStats(c, params)
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(c *gin.Context) {
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+	// ------------- Optional query parameter "from" -------------
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "to" -------------
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "team_name" -------------
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsFairness(c, params)
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetStatsLatency operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsLatency(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsLatencyParams
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "from" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	// ------------- Optional query parameter "to" -------------
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetStatsRequestObject struct {
+	siw.Handler.GetStatsLatency(c, params)
 }
 
-type GetStatsResponseObject interface {
-	VisitGetStatsResponse(w http.ResponseWriter) error
-}
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
 
-type GetStats200JSONResponse struct {
-	// ByPr Количество назначений для каждого PR
-	ByPr *[]struct {
-		PullRequestId  string `json:"pull_request_id"`
-		ReviewersCount int    `json:"reviewers_count"`
-	} `json:"by_pr,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ByUser Количество назначений ревьюверов по каждому пользователю
-	ByUser *[]struct {
-		AssignmentsCount int    `json:"assignments_count"`
-		UserId           string `json:"user_id"`
-	} `json:"by_user,omitempty"`
+	siw.Handler.PostTeamAdd(c)
 }
 
-func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamDeactivateUsers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivateUsers(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+	siw.Handler.PostTeamDeactivateUsers(c)
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	var err error
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "team_name" -------------
 
-type PostTeamAdd400JSONResponse ErrorResponse
+	if paramValue := c.Query("team_name"); paramValue != "" {
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostTeamDeactivateUsersRequestObject struct {
-	Body *PostTeamDeactivateUsersJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostTeamDeactivateUsersResponseObject interface {
-	VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error
+	siw.Handler.GetTeamGet(c, params)
 }
 
-type PostTeamDeactivateUsers200JSONResponse struct {
-	// Deactivated user_id деактивированных пользователей
-	Deactivated []string `json:"deactivated"`
+// GetTeamGetFallbacks operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetFallbacks(c *gin.Context) {
 
-	// FailedCount Количество PR без доступных активных ревьюверов
-	FailedCount *int `json:"failed_count,omitempty"`
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetFallbacksParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamGetFallbacks(c, params)
+}
+
+// PostTeamSetFallbacks operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetFallbacks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetFallbacks(c)
+}
+
+// PostTeamSetPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetPolicy(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetPolicy(c)
+}
+
+// PostUsersAddUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddUnavailability(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersAddUnavailability(c)
+}
+
+// PostUsersDeleteUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDeleteUnavailability(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersDeleteUnavailability(c)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pending_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pending_only", c.Request.URL.Query(), &params.PendingOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pending_only: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetReview(c, params)
+}
+
+// GetUsersGetUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetUnavailability(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetUnavailabilityParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetUnavailability(c, params)
+}
+
+// GetUsersHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersHistory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersHistoryParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersHistory(c, params)
+}
+
+// PostUsersSetEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetEmail(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetEmail(c)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetIsActive(c)
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetMaxOpenReviews(c)
+}
+
+// PostUsersSetTags operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetTags(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetTags(c)
+}
+
+// PostUsersUpdateUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUpdateUnavailability(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUpdateUnavailability(c)
+}
+
+// PostWebhooksCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksCreate(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksCreate(c)
+}
+
+// PostWebhooksDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDelete(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksDelete(c)
+}
+
+// GetWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveries(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := c.Query("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument subscription_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", c.Request.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscription_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooksDeliveries(c, params)
+}
+
+// GetWebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooksList(c)
+}
+
+// PostWebhooksRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksRedeliver(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksRedeliver(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/codeowners/get", wrapper.GetCodeownersGet)
+	router.POST(options.BaseURL+"/codeowners/set", wrapper.PostCodeownersSet)
+	router.POST(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	router.GET(options.BaseURL+"/stats", wrapper.GetStats)
+	router.GET(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	router.GET(options.BaseURL+"/stats/latency", wrapper.GetStatsLatency)
+	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(options.BaseURL+"/team/deactivateUsers", wrapper.PostTeamDeactivateUsers)
+	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(options.BaseURL+"/team/getFallbacks", wrapper.GetTeamGetFallbacks)
+	router.POST(options.BaseURL+"/team/setFallbacks", wrapper.PostTeamSetFallbacks)
+	router.POST(options.BaseURL+"/team/setPolicy", wrapper.PostTeamSetPolicy)
+	router.POST(options.BaseURL+"/users/addUnavailability", wrapper.PostUsersAddUnavailability)
+	router.POST(options.BaseURL+"/users/deleteUnavailability", wrapper.PostUsersDeleteUnavailability)
+	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(options.BaseURL+"/users/getUnavailability", wrapper.GetUsersGetUnavailability)
+	router.GET(options.BaseURL+"/users/history", wrapper.GetUsersHistory)
+	router.POST(options.BaseURL+"/users/setEmail", wrapper.PostUsersSetEmail)
+	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	router.POST(options.BaseURL+"/users/setTags", wrapper.PostUsersSetTags)
+	router.POST(options.BaseURL+"/users/updateUnavailability", wrapper.PostUsersUpdateUnavailability)
+	router.POST(options.BaseURL+"/webhooks/create", wrapper.PostWebhooksCreate)
+	router.POST(options.BaseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	router.GET(options.BaseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+	router.GET(options.BaseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	router.POST(options.BaseURL+"/webhooks/redeliver", wrapper.PostWebhooksRedeliver)
+}
+
+type GetCodeownersGetRequestObject struct {
+}
+
+type GetCodeownersGetResponseObject interface {
+	VisitGetCodeownersGetResponse(w http.ResponseWriter) error
+}
+
+type GetCodeownersGet200JSONResponse struct {
+	Rules []CodeOwnerRule `json:"rules"`
+}
+
+func (response GetCodeownersGet200JSONResponse) VisitGetCodeownersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCodeownersSetRequestObject struct {
+	Body *PostCodeownersSetJSONRequestBody
+}
+
+type PostCodeownersSetResponseObject interface {
+	VisitPostCodeownersSetResponse(w http.ResponseWriter) error
+}
+
+type PostCodeownersSet200JSONResponse struct {
+	Rules []CodeOwnerRule `json:"rules"`
+}
+
+func (response PostCodeownersSet200JSONResponse) VisitPostCodeownersSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCodeownersSet400JSONResponse ErrorResponse
+
+func (response PostCodeownersSet400JSONResponse) VisitPostCodeownersSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse struct {
+	Events        []AssignmentEvent `json:"events"`
+	PullRequestId string            `json:"pull_request_id"`
+}
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge403JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge403JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Body *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewRequestObject struct {
+	Body *PostPullRequestReviewJSONRequestBody
+}
+
+type PostPullRequestReviewResponseObject interface {
+	VisitPostPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReview200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReview200JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestReview400JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestReview404JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestReview409JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse struct {
+	// ByDay PR и назначения по дню создания PR
+	ByDay *[]struct {
+		AssignmentsCount  int    `json:"assignments_count"`
+		Day               string `json:"day"`
+		PullRequestsCount int    `json:"pull_requests_count"`
+	} `json:"by_day,omitempty"`
+
+	// ByPr Количество назначений для каждого PR
+	ByPr *[]struct {
+		PullRequestId  string `json:"pull_request_id"`
+		ReviewersCount int    `json:"reviewers_count"`
+	} `json:"by_pr,omitempty"`
+
+	// ByTeam PR авторов из команды и назначения ревьюверов из команды
+	ByTeam *[]struct {
+		AssignmentsCount  int    `json:"assignments_count"`
+		PullRequestsCount int    `json:"pull_requests_count"`
+		TeamName          string `json:"team_name"`
+	} `json:"by_team,omitempty"`
+
+	// ByUser Количество назначений ревьюверов по каждому пользователю
+	ByUser *[]struct {
+		AssignmentsCount int    `json:"assignments_count"`
+		UserId           string `json:"user_id"`
+	} `json:"by_user,omitempty"`
+}
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats400JSONResponse ErrorResponse
+
+func (response GetStats400JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats404JSONResponse ErrorResponse
+
+func (response GetStats404JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairnessRequestObject struct {
+	Params GetStatsFairnessParams
+}
+
+type GetStatsFairnessResponseObject interface {
+	VisitGetStatsFairnessResponse(w http.ResponseWriter) error
+}
+
+type GetStatsFairness200JSONResponse struct {
+	From  time.Time      `json:"from"`
+	Teams []TeamFairness `json:"teams"`
+	To    time.Time      `json:"to"`
+}
+
+func (response GetStatsFairness200JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness400JSONResponse ErrorResponse
+
+func (response GetStatsFairness400JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness404JSONResponse ErrorResponse
+
+func (response GetStatsFairness404JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsLatencyRequestObject struct {
+	Params GetStatsLatencyParams
+}
+
+type GetStatsLatencyResponseObject interface {
+	VisitGetStatsLatencyResponse(w http.ResponseWriter) error
+}
+
+type GetStatsLatency200JSONResponse struct {
+	ByTeam []TeamLatency `json:"by_team"`
+	ByUser []UserLatency `json:"by_user"`
+	From   time.Time     `json:"from"`
+	To     time.Time     `json:"to"`
+}
+
+func (response GetStatsLatency200JSONResponse) VisitGetStatsLatencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsLatency400JSONResponse ErrorResponse
+
+func (response GetStatsLatency400JSONResponse) VisitGetStatsLatencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsersRequestObject struct {
+	Body *PostTeamDeactivateUsersJSONRequestBody
+}
+
+type PostTeamDeactivateUsersResponseObject interface {
+	VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDeactivateUsers200JSONResponse struct {
+	// Deactivated user_id деактивированных пользователей
+	Deactivated []string `json:"deactivated"`
+
+	// FailedCount Количество PR без доступных активных ревьюверов
+	FailedCount *int `json:"failed_count,omitempty"`
 
 	// ReassignedCount Количество успешно переназначенных PR
 	ReassignedCount int            `json:"reassigned_count"`
@@ -577,144 +2129,1507 @@ type PostTeamDeactivateUsers200JSONResponse struct {
 	UpdatedPrs      *[]PullRequest `json:"updated_prs,omitempty"`
 }
 
-func (response PostTeamDeactivateUsers200JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response PostTeamDeactivateUsers200JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers404JSONResponse ErrorResponse
+
+func (response PostTeamDeactivateUsers404JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetFallbacksRequestObject struct {
+	Params GetTeamGetFallbacksParams
+}
+
+type GetTeamGetFallbacksResponseObject interface {
+	VisitGetTeamGetFallbacksResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGetFallbacks200JSONResponse TeamFallbacks
+
+func (response GetTeamGetFallbacks200JSONResponse) VisitGetTeamGetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetFallbacks404JSONResponse ErrorResponse
+
+func (response GetTeamGetFallbacks404JSONResponse) VisitGetTeamGetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacksRequestObject struct {
+	Body *PostTeamSetFallbacksJSONRequestBody
+}
+
+type PostTeamSetFallbacksResponseObject interface {
+	VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetFallbacks200JSONResponse TeamFallbacks
+
+func (response PostTeamSetFallbacks200JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks400JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks400JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks404JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks404JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicyRequestObject struct {
+	Body *PostTeamSetPolicyJSONRequestBody
+}
+
+type PostTeamSetPolicyResponseObject interface {
+	VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetPolicy200JSONResponse struct {
+	Policy TeamPolicy `json:"policy"`
+}
+
+func (response PostTeamSetPolicy200JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicy400JSONResponse ErrorResponse
+
+func (response PostTeamSetPolicy400JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicy404JSONResponse ErrorResponse
+
+func (response PostTeamSetPolicy404JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailabilityRequestObject struct {
+	Body *PostUsersAddUnavailabilityJSONRequestBody
+}
+
+type PostUsersAddUnavailabilityResponseObject interface {
+	VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAddUnavailability201JSONResponse struct {
+	Period          Unavailability `json:"period"`
+	ReassignedCount int            `json:"reassigned_count"`
+}
+
+func (response PostUsersAddUnavailability201JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability400JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability400JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability404JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeleteUnavailabilityRequestObject struct {
+	Body *PostUsersDeleteUnavailabilityJSONRequestBody
+}
+
+type PostUsersDeleteUnavailabilityResponseObject interface {
+	VisitPostUsersDeleteUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersDeleteUnavailability204Response struct {
+}
+
+func (response PostUsersDeleteUnavailability204Response) VisitPostUsersDeleteUnavailabilityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostUsersDeleteUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersDeleteUnavailability404JSONResponse) VisitPostUsersDeleteUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	// NextCursor Курсор следующей страницы; null — страниц больше нет
+	NextCursor   *string            `json:"next_cursor"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse ErrorResponse
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview404JSONResponse ErrorResponse
+
+func (response GetUsersGetReview404JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetUnavailabilityRequestObject struct {
+	Params GetUsersGetUnavailabilityParams
+}
+
+type GetUsersGetUnavailabilityResponseObject interface {
+	VisitGetUsersGetUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetUnavailability200JSONResponse struct {
+	Periods []Unavailability `json:"periods"`
+	UserId  string           `json:"user_id"`
+}
+
+func (response GetUsersGetUnavailability200JSONResponse) VisitGetUsersGetUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetUnavailability404JSONResponse ErrorResponse
+
+func (response GetUsersGetUnavailability404JSONResponse) VisitGetUsersGetUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersHistoryRequestObject struct {
+	Params GetUsersHistoryParams
+}
+
+type GetUsersHistoryResponseObject interface {
+	VisitGetUsersHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetUsersHistory200JSONResponse struct {
+	Events []AssignmentEvent `json:"events"`
+	UserId string            `json:"user_id"`
+}
+
+func (response GetUsersHistory200JSONResponse) VisitGetUsersHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersHistory404JSONResponse ErrorResponse
+
+func (response GetUsersHistory404JSONResponse) VisitGetUsersHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetEmailRequestObject struct {
+	Body *PostUsersSetEmailJSONRequestBody
+}
+
+type PostUsersSetEmailResponseObject interface {
+	VisitPostUsersSetEmailResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetEmail200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetEmail200JSONResponse) VisitPostUsersSetEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetEmail400JSONResponse ErrorResponse
+
+func (response PostUsersSetEmail400JSONResponse) VisitPostUsersSetEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetEmail404JSONResponse ErrorResponse
+
+func (response PostUsersSetEmail404JSONResponse) VisitPostUsersSetEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Body *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews400JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTagsRequestObject struct {
+	Body *PostUsersSetTagsJSONRequestBody
+}
+
+type PostUsersSetTagsResponseObject interface {
+	VisitPostUsersSetTagsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetTags200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetTags200JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags400JSONResponse ErrorResponse
+
+func (response PostUsersSetTags400JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetTags404JSONResponse ErrorResponse
+
+func (response PostUsersSetTags404JSONResponse) VisitPostUsersSetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUpdateUnavailabilityRequestObject struct {
+	Body *PostUsersUpdateUnavailabilityJSONRequestBody
+}
+
+type PostUsersUpdateUnavailabilityResponseObject interface {
+	VisitPostUsersUpdateUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUpdateUnavailability200JSONResponse struct {
+	Period Unavailability `json:"period"`
+}
+
+func (response PostUsersUpdateUnavailability200JSONResponse) VisitPostUsersUpdateUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUpdateUnavailability400JSONResponse ErrorResponse
+
+func (response PostUsersUpdateUnavailability400JSONResponse) VisitPostUsersUpdateUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUpdateUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersUpdateUnavailability404JSONResponse) VisitPostUsersUpdateUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksCreateRequestObject struct {
+	Body *PostWebhooksCreateJSONRequestBody
+}
+
+type PostWebhooksCreateResponseObject interface {
+	VisitPostWebhooksCreateResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksCreate201JSONResponse struct {
+	// Secret Секрет подписки, больше нигде не возвращается
+	Secret       string              `json:"secret"`
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+func (response PostWebhooksCreate201JSONResponse) VisitPostWebhooksCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksCreate400JSONResponse ErrorResponse
+
+func (response PostWebhooksCreate400JSONResponse) VisitPostWebhooksCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksCreate404JSONResponse ErrorResponse
+
+func (response PostWebhooksCreate404JSONResponse) VisitPostWebhooksCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDeleteRequestObject struct {
+	Body *PostWebhooksDeleteJSONRequestBody
+}
+
+type PostWebhooksDeleteResponseObject interface {
+	VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksDelete204Response struct {
+}
+
+func (response PostWebhooksDelete204Response) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostWebhooksDelete404JSONResponse ErrorResponse
+
+func (response PostWebhooksDelete404JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveriesRequestObject struct {
+	Params GetWebhooksDeliveriesParams
+}
+
+type GetWebhooksDeliveriesResponseObject interface {
+	VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksDeliveries200JSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+func (response GetWebhooksDeliveries200JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveries404JSONResponse ErrorResponse
+
+func (response GetWebhooksDeliveries404JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksListRequestObject struct {
+}
+
+type GetWebhooksListResponseObject interface {
+	VisitGetWebhooksListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksList200JSONResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+func (response GetWebhooksList200JSONResponse) VisitGetWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRedeliverRequestObject struct {
+	Body *PostWebhooksRedeliverJSONRequestBody
+}
+
+type PostWebhooksRedeliverResponseObject interface {
+	VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksRedeliver200JSONResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+func (response PostWebhooksRedeliver200JSONResponse) VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRedeliver404JSONResponse ErrorResponse
+
+func (response PostWebhooksRedeliver404JSONResponse) VisitPostWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить текущие правила владения кодом
+	// (GET /codeowners/get)
+	GetCodeownersGet(ctx context.Context, request GetCodeownersGetRequestObject) (GetCodeownersGetResponseObject, error)
+	// Загрузить правила владения кодом в формате CODEOWNERS (заменяет текущие)
+	// (POST /codeowners/set)
+	PostCodeownersSet(ctx context.Context, request PostCodeownersSetRequestObject) (PostCodeownersSetResponseObject, error)
+	// Закрыть PR без merge и освободить ревьюверов (идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов из команды автора согласно политике команды
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Журнал назначений ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды (или резервных команд)
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Переоткрыть закрытый PR и заново назначить ревьюверов
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Зафиксировать вердикт ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx context.Context, request PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error)
+	// Получить статистику назначений по пользователям, PR, командам и дням
+	// (GET /stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Равномерность назначений среди активных участников команд
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx context.Context, request GetStatsFairnessRequestObject) (GetStatsFairnessResponseObject, error)
+	// Медиана и p90 времени до первого ревью и до merge по пользователям и командам
+	// (GET /stats/latency)
+	GetStatsLatency(ctx context.Context, request GetStatsLatencyRequestObject) (GetStatsLatencyResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Массово деактивировать пользователей команды
+	// (POST /team/deactivateUsers)
+	PostTeamDeactivateUsers(ctx context.Context, request PostTeamDeactivateUsersRequestObject) (PostTeamDeactivateUsersResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Получить цепочку резервных команд
+	// (GET /team/getFallbacks)
+	GetTeamGetFallbacks(ctx context.Context, request GetTeamGetFallbacksRequestObject) (GetTeamGetFallbacksResponseObject, error)
+	// Задать цепочку резервных команд для назначения ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(ctx context.Context, request PostTeamSetFallbacksRequestObject) (PostTeamSetFallbacksResponseObject, error)
+	// Задать политику назначения ревьюверов для команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(ctx context.Context, request PostTeamSetPolicyRequestObject) (PostTeamSetPolicyResponseObject, error)
+	// Добавить период недоступности пользователя (на это время он не назначается ревьювером)
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(ctx context.Context, request PostUsersAddUnavailabilityRequestObject) (PostUsersAddUnavailabilityResponseObject, error)
+	// Удалить период недоступности
+	// (POST /users/deleteUnavailability)
+	PostUsersDeleteUnavailability(ctx context.Context, request PostUsersDeleteUnavailabilityRequestObject) (PostUsersDeleteUnavailabilityResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Получить периоды недоступности пользователя
+	// (GET /users/getUnavailability)
+	GetUsersGetUnavailability(ctx context.Context, request GetUsersGetUnavailabilityRequestObject) (GetUsersGetUnavailabilityResponseObject, error)
+	// События назначения и снятия пользователя с ревью
+	// (GET /users/history)
+	GetUsersHistory(ctx context.Context, request GetUsersHistoryRequestObject) (GetUsersHistoryResponseObject, error)
+	// Задать email для уведомлений о назначениях и ежедневной сводки
+	// (POST /users/setEmail)
+	PostUsersSetEmail(ctx context.Context, request PostUsersSetEmailRequestObject) (PostUsersSetEmailResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Задать личный лимит одновременно открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Задать навыки пользователя (заменяет текущие)
+	// (POST /users/setTags)
+	PostUsersSetTags(ctx context.Context, request PostUsersSetTagsRequestObject) (PostUsersSetTagsResponseObject, error)
+	// Изменить период недоступности
+	// (POST /users/updateUnavailability)
+	PostUsersUpdateUnavailability(ctx context.Context, request PostUsersUpdateUnavailabilityRequestObject) (PostUsersUpdateUnavailabilityResponseObject, error)
+	// Подписаться на события PR
+	// (POST /webhooks/create)
+	PostWebhooksCreate(ctx context.Context, request PostWebhooksCreateRequestObject) (PostWebhooksCreateResponseObject, error)
+	// Удалить подписку вместе с историей доставок
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx context.Context, request PostWebhooksDeleteRequestObject) (PostWebhooksDeleteResponseObject, error)
+	// Последние доставки подписки (новые первыми)
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(ctx context.Context, request GetWebhooksDeliveriesRequestObject) (GetWebhooksDeliveriesResponseObject, error)
+	// Получить подписки на события
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx context.Context, request GetWebhooksListRequestObject) (GetWebhooksListResponseObject, error)
+	// Повторно отправить доставку
+	// (POST /webhooks/redeliver)
+	PostWebhooksRedeliver(ctx context.Context, request PostWebhooksRedeliverRequestObject) (PostWebhooksRedeliverResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCodeownersGet operation middleware
+func (sh *strictHandler) GetCodeownersGet(ctx *gin.Context) {
+	var request GetCodeownersGetRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCodeownersGet(ctx, request.(GetCodeownersGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCodeownersGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCodeownersGetResponseObject); ok {
+		if err := validResponse.VisitGetCodeownersGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCodeownersSet operation middleware
+func (sh *strictHandler) PostCodeownersSet(ctx *gin.Context) {
+	var request PostCodeownersSetRequestObject
+
+	var body PostCodeownersSetJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCodeownersSet(ctx, request.(PostCodeownersSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCodeownersSet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCodeownersSetResponseObject); ok {
+		if err := validResponse.VisitPostCodeownersSetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(ctx *gin.Context) {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(ctx *gin.Context, params GetPullRequestHistoryParams) {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx, request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(ctx *gin.Context) {
+	var request PostPullRequestReadyRequestObject
+
+	var body PostPullRequestReadyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx, request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReadyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(ctx *gin.Context) {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReview operation middleware
+func (sh *strictHandler) PostPullRequestReview(ctx *gin.Context) {
+	var request PostPullRequestReviewRequestObject
+
+	var body PostPullRequestReviewJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReview(ctx, request.(PostPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(ctx *gin.Context, params GetStatsParams) {
+	var request GetStatsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamDeactivateUsers404JSONResponse ErrorResponse
+// GetStatsFairness operation middleware
+func (sh *strictHandler) GetStatsFairness(ctx *gin.Context, params GetStatsFairnessParams) {
+	var request GetStatsFairnessRequestObject
 
-func (response PostTeamDeactivateUsers404JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsFairness(ctx, request.(GetStatsFairnessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsFairness")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsFairnessResponseObject); ok {
+		if err := validResponse.VisitGetStatsFairnessResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+// GetStatsLatency operation middleware
+func (sh *strictHandler) GetStatsLatency(ctx *gin.Context, params GetStatsLatencyParams) {
+	var request GetStatsLatencyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsLatency(ctx, request.(GetStatsLatencyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsLatency")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsLatencyResponseObject); ok {
+		if err := validResponse.VisitGetStatsLatencyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamDeactivateUsers operation middleware
+func (sh *strictHandler) PostTeamDeactivateUsers(ctx *gin.Context) {
+	var request PostTeamDeactivateUsersRequestObject
+
+	var body PostTeamDeactivateUsersJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDeactivateUsers(ctx, request.(PostTeamDeactivateUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDeactivateUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamDeactivateUsersResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeactivateUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGetFallbacks operation middleware
+func (sh *strictHandler) GetTeamGetFallbacks(ctx *gin.Context, params GetTeamGetFallbacksParams) {
+	var request GetTeamGetFallbacksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGetFallbacks(ctx, request.(GetTeamGetFallbacksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGetFallbacks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTeamGetFallbacksResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetFallbacksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamSetFallbacks operation middleware
+func (sh *strictHandler) PostTeamSetFallbacks(ctx *gin.Context) {
+	var request PostTeamSetFallbacksRequestObject
+
+	var body PostTeamSetFallbacksJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetFallbacks(ctx, request.(PostTeamSetFallbacksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetFallbacks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamSetFallbacksResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetFallbacksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamSetPolicy operation middleware
+func (sh *strictHandler) PostTeamSetPolicy(ctx *gin.Context) {
+	var request PostTeamSetPolicyRequestObject
+
+	var body PostTeamSetPolicyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetPolicy(ctx, request.(PostTeamSetPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamSetPolicyResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetPolicyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersAddUnavailability operation middleware
+func (sh *strictHandler) PostUsersAddUnavailability(ctx *gin.Context) {
+	var request PostUsersAddUnavailabilityRequestObject
+
+	var body PostUsersAddUnavailabilityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAddUnavailability(ctx, request.(PostUsersAddUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAddUnavailability")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersAddUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersAddUnavailabilityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+// PostUsersDeleteUnavailability operation middleware
+func (sh *strictHandler) PostUsersDeleteUnavailability(ctx *gin.Context) {
+	var request PostUsersDeleteUnavailabilityRequestObject
 
-type GetTeamGet200JSONResponse Team
+	var body PostUsersDeleteUnavailabilityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersDeleteUnavailability(ctx, request.(PostUsersDeleteUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersDeleteUnavailability")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetTeamGet404JSONResponse ErrorResponse
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersDeleteUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersDeleteUnavailabilityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUsersGetUnavailability operation middleware
+func (sh *strictHandler) GetUsersGetUnavailability(ctx *gin.Context, params GetUsersGetUnavailabilityParams) {
+	var request GetUsersGetUnavailabilityRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetUnavailability(ctx, request.(GetUsersGetUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetUnavailability")
+	}
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersGetUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetUnavailabilityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUsersHistory operation middleware
+func (sh *strictHandler) GetUsersHistory(ctx *gin.Context, params GetUsersHistoryParams) {
+	var request GetUsersHistoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersHistory(ctx, request.(GetUsersHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersHistory")
+	}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersHistoryResponseObject); ok {
+		if err := validResponse.VisitGetUsersHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Получить статистику назначений по пользователям и PR
-	// (GET /stats)
-	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Массово деактивировать пользователей команды
-	// (POST /team/deactivateUsers)
-	PostTeamDeactivateUsers(ctx context.Context, request PostTeamDeactivateUsersRequestObject) (PostTeamDeactivateUsersResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// PostUsersSetEmail operation middleware
+func (sh *strictHandler) PostUsersSetEmail(ctx *gin.Context) {
+	var request PostUsersSetEmailRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	var body PostUsersSetEmailJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetEmail(ctx, request.(PostUsersSetEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetEmail")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersSetEmailResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetEmailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
-	var request PostPullRequestCreateRequestObject
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
+	var request PostUsersSetIsActiveRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostUsersSetIsActiveJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -723,10 +3638,10 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostUsersSetIsActive")
 	}
 
 	response, err := handler(ctx, request)
@@ -734,8 +3649,8 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -743,11 +3658,11 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
-	var request PostPullRequestMergeRequestObject
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(ctx *gin.Context) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -756,10 +3671,10 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
 	}
 
 	response, err := handler(ctx, request)
@@ -767,8 +3682,8 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -776,11 +3691,11 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersSetTags operation middleware
+func (sh *strictHandler) PostUsersSetTags(ctx *gin.Context) {
+	var request PostUsersSetTagsRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostUsersSetTagsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -789,10 +3704,10 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersSetTags(ctx, request.(PostUsersSetTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersSetTags")
 	}
 
 	response, err := handler(ctx, request)
@@ -800,8 +3715,8 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetTagsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetTagsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -809,15 +3724,23 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	}
 }
 
-// GetStats operation middleware
-func (sh *strictHandler) GetStats(ctx *gin.Context) {
-	var request GetStatsRequestObject
+// PostUsersUpdateUnavailability operation middleware
+func (sh *strictHandler) PostUsersUpdateUnavailability(ctx *gin.Context) {
+	var request PostUsersUpdateUnavailabilityRequestObject
+
+	var body PostUsersUpdateUnavailabilityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
+		return sh.ssi.PostUsersUpdateUnavailability(ctx, request.(PostUsersUpdateUnavailabilityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStats")
+		handler = middleware(handler, "PostUsersUpdateUnavailability")
 	}
 
 	response, err := handler(ctx, request)
@@ -825,8 +3748,8 @@ func (sh *strictHandler) GetStats(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
-		if err := validResponse.VisitGetStatsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUpdateUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersUpdateUnavailabilityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -834,11 +3757,11 @@ func (sh *strictHandler) GetStats(ctx *gin.Context) {
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
-	var request PostTeamAddRequestObject
+// PostWebhooksCreate operation middleware
+func (sh *strictHandler) PostWebhooksCreate(ctx *gin.Context) {
+	var request PostWebhooksCreateRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostWebhooksCreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -847,10 +3770,10 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostWebhooksCreate(ctx, request.(PostWebhooksCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostWebhooksCreate")
 	}
 
 	response, err := handler(ctx, request)
@@ -858,8 +3781,8 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksCreateResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksCreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -867,11 +3790,11 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	}
 }
 
-// PostTeamDeactivateUsers operation middleware
-func (sh *strictHandler) PostTeamDeactivateUsers(ctx *gin.Context) {
-	var request PostTeamDeactivateUsersRequestObject
+// PostWebhooksDelete operation middleware
+func (sh *strictHandler) PostWebhooksDelete(ctx *gin.Context) {
+	var request PostWebhooksDeleteRequestObject
 
-	var body PostTeamDeactivateUsersJSONRequestBody
+	var body PostWebhooksDeleteJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -880,10 +3803,10 @@ func (sh *strictHandler) PostTeamDeactivateUsers(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamDeactivateUsers(ctx, request.(PostTeamDeactivateUsersRequestObject))
+		return sh.ssi.PostWebhooksDelete(ctx, request.(PostWebhooksDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamDeactivateUsers")
+		handler = middleware(handler, "PostWebhooksDelete")
 	}
 
 	response, err := handler(ctx, request)
@@ -891,8 +3814,8 @@ func (sh *strictHandler) PostTeamDeactivateUsers(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTeamDeactivateUsersResponseObject); ok {
-		if err := validResponse.VisitPostTeamDeactivateUsersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksDeleteResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksDeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -900,17 +3823,17 @@ func (sh *strictHandler) PostTeamDeactivateUsers(ctx *gin.Context) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetWebhooksDeliveries operation middleware
+func (sh *strictHandler) GetWebhooksDeliveries(ctx *gin.Context, params GetWebhooksDeliveriesParams) {
+	var request GetWebhooksDeliveriesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetWebhooksDeliveries(ctx, request.(GetWebhooksDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetWebhooksDeliveries")
 	}
 
 	response, err := handler(ctx, request)
@@ -918,8 +3841,8 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeliveriesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -927,17 +3850,15 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
-
-	request.Params = params
+// GetWebhooksList operation middleware
+func (sh *strictHandler) GetWebhooksList(ctx *gin.Context) {
+	var request GetWebhooksListRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.GetWebhooksList(ctx, request.(GetWebhooksListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "GetWebhooksList")
 	}
 
 	response, err := handler(ctx, request)
@@ -945,8 +3866,8 @@ func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetR
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksListResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -954,11 +3875,11 @@ func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetR
 	}
 }
 
-// PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
-	var request PostUsersSetIsActiveRequestObject
+// PostWebhooksRedeliver operation middleware
+func (sh *strictHandler) PostWebhooksRedeliver(ctx *gin.Context) {
+	var request PostWebhooksRedeliverRequestObject
 
-	var body PostUsersSetIsActiveJSONRequestBody
+	var body PostWebhooksRedeliverJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -967,10 +3888,10 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+		return sh.ssi.PostWebhooksRedeliver(ctx, request.(PostWebhooksRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersSetIsActive")
+		handler = middleware(handler, "PostWebhooksRedeliver")
 	}
 
 	response, err := handler(ctx, request)
@@ -978,8 +3899,8 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
-		if err := validResponse.VisitPostUsersSetIsActiveResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksRedeliverResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
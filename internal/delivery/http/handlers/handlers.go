@@ -4,21 +4,48 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	api "github.com/3eLLenKa/test-avito/internal/delivery/http/gen"
 	"github.com/3eLLenKa/test-avito/internal/domain"
 )
 
 type Service interface {
-	PullRequestCreate(ctx context.Context, prId, prName, authorId string) (*domain.PullRequest, error)
-	PullRequestMerge(ctx context.Context, prId string) (*domain.PullRequest, error)
-	PullRequestReassign(ctx context.Context, prId, oldUserId string) (*domain.PullRequest, string, error)
+	PullRequestCreate(ctx context.Context, params domain.PullRequestCreateParams) (*domain.PullRequest, error)
+	PullRequestReady(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+	PullRequestClose(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+	PullRequestReopen(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+	PullRequestMerge(ctx context.Context, prId string, opts domain.MergeOptions) (*domain.PullRequest, error)
+	PullRequestReassign(ctx context.Context, prId, oldUserId, actorId string) (*domain.PullRequest, string, error)
+	PullRequestHistory(ctx context.Context, prId string) ([]domain.AssignmentEvent, error)
 	TeamAdd(ctx context.Context, teamName string, members []domain.User) (*domain.Team, error)
 	TeamGet(ctx context.Context, teamName string) (*domain.Team, error)
-	UsersGetReview(ctx context.Context, userId string) ([]*domain.PullRequest, error)
+	TeamSetPolicy(ctx context.Context, policy domain.TeamPolicy) (*domain.TeamPolicy, error)
+	TeamSetFallbacks(ctx context.Context, teamName string, fallbacks []string) ([]string, error)
+	TeamGetFallbacks(ctx context.Context, teamName string) ([]string, error)
+	PullRequestReview(ctx context.Context, prId, reviewerId string, state domain.ReviewState) (*domain.PullRequest, error)
+	UsersGetReview(ctx context.Context, q domain.ReviewInboxQuery, cursor string) ([]*domain.PullRequest, string, error)
 	SetUserActive(ctx context.Context, userId string, isActive bool) (*domain.User, error)
+	UserSetTags(ctx context.Context, userId string, tags []string) (*domain.User, error)
+	UserSetEmail(ctx context.Context, userId string, email string) (*domain.User, error)
+	UserHistory(ctx context.Context, userId string) ([]domain.AssignmentEvent, error)
+	UserSetMaxOpenReviews(ctx context.Context, userId string, limit *int) (*domain.User, error)
+	UserAddUnavailability(ctx context.Context, period domain.Unavailability, reassignOpen bool) (*domain.Unavailability, int, error)
+	UserUpdateUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error)
+	UserDeleteUnavailability(ctx context.Context, id int64) error
+	UserListUnavailability(ctx context.Context, userId string) ([]domain.Unavailability, error)
 	TeamDeactivateUsers(ctx context.Context, teamName string) ([]string, []*domain.PullRequest, int, int, error)
-	GetAssignmentStats(ctx context.Context) ([]domain.AssignmentCountByUser, []domain.AssignmentCountByPR, error)
+	CodeOwnersSet(ctx context.Context, content string) ([]domain.CodeOwnerRule, error)
+	CodeOwnersGet(ctx context.Context) ([]domain.CodeOwnerRule, error)
+	GetAssignmentStats(ctx context.Context, filter domain.StatsFilter) (*domain.AssignmentStats, error)
+	StatsLatency(ctx context.Context, from, to *time.Time) (*domain.LatencyStats, error)
+	StatsFairness(ctx context.Context, teamName string, from, to *time.Time) (*domain.FairnessReport, error)
+	WebhookCreate(ctx context.Context, sub domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	WebhookList(ctx context.Context) ([]domain.WebhookSubscription, error)
+	WebhookDelete(ctx context.Context, id int64) error
+	WebhookDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]domain.WebhookDelivery, error)
+	WebhookRedeliver(ctx context.Context, deliveryId int64) (*domain.WebhookDelivery, error)
 }
 
 type Handlers struct {
@@ -30,11 +57,20 @@ func NewHandlers(svc Service) api.StrictServerInterface {
 }
 
 func (h *Handlers) PostPullRequestCreate(ctx context.Context, request api.PostPullRequestCreateRequestObject) (api.PostPullRequestCreateResponseObject, error) {
-	pr, err := h.svc.PullRequestCreate(ctx,
-		request.Body.PullRequestId,
-		request.Body.PullRequestName,
-		request.Body.AuthorId,
-	)
+	params := domain.PullRequestCreateParams{
+		ID:       request.Body.PullRequestId,
+		Name:     request.Body.PullRequestName,
+		AuthorID: request.Body.AuthorId,
+		Draft:    request.Body.Draft != nil && *request.Body.Draft,
+	}
+	if request.Body.ChangedPaths != nil {
+		params.ChangedPaths = *request.Body.ChangedPaths
+	}
+	if request.Body.RequiredTags != nil {
+		params.RequiredTags = *request.Body.RequiredTags
+	}
+
+	pr, err := h.svc.PullRequestCreate(ctx, params)
 
 	if err != nil {
 		switch {
@@ -51,50 +87,69 @@ func (h *Handlers) PostPullRequestCreate(ctx context.Context, request api.PostPu
 			return api.PostPullRequestCreate404JSONResponse(
 				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "author's team not found"),
 			), nil
+		case errors.Is(err, domain.ErrNoCandidate):
+			return api.PostPullRequestCreate409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOCANDIDATE, "not enough reviewers for team policy"),
+			), nil
+		case errors.Is(err, domain.ErrCapacityExhausted):
+			return api.PostPullRequestCreate409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeCAPACITYEXHAUSTED, "all candidates reached their open review limit"),
+			), nil
+		case errors.Is(err, domain.ErrTagsNotCovered):
+			return api.PostPullRequestCreate409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeTAGSNOTCOVERED, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTag):
+			return api.PostPullRequestCreate400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTAG, errorText(err)),
+			), nil
 		default:
 			return nil, err
 		}
 	}
 
 	return api.PostPullRequestCreate201JSONResponse{
-		Pr: &api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			AssignedReviewers: pr.AssignedReviewers,
-			MergedAt:          pr.MergedAt,
-			CreatedAt:         pr.CreatedAt,
-			Status:            api.PullRequestStatus(pr.Status),
-		},
+		Pr: toAPIPullRequest(pr),
 	}, nil
 }
 
 func (h *Handlers) PostPullRequestMerge(ctx context.Context, request api.PostPullRequestMergeRequestObject) (api.PostPullRequestMergeResponseObject, error) {
-	pr, err := h.svc.PullRequestMerge(ctx, request.Body.PullRequestId)
+	opts := domain.MergeOptions{
+		Force:   request.Body.Force != nil && *request.Body.Force,
+		ActorID: stringValue(request.Body.ActorId),
+		Reason:  stringValue(request.Body.Reason),
+	}
+
+	pr, err := h.svc.PullRequestMerge(ctx, request.Body.PullRequestId, opts)
 	if err != nil {
-		if errors.Is(err, domain.ErrPRNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrPRNotFound):
 			return api.PostPullRequestMerge404JSONResponse(
 				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found"),
 			), nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.PostPullRequestMerge403JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeFORBIDDEN, "actor is not allowed to force merge"),
+			), nil
+		case errors.Is(err, domain.ErrNotApproved):
+			return api.PostPullRequestMerge409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTAPPROVED, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return api.PostPullRequestMerge409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTRANSITION, "only open PR can be merged"),
+			), nil
 		}
 		return nil, err
 	}
 
 	return api.PostPullRequestMerge200JSONResponse{
-		Pr: &api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			AssignedReviewers: pr.AssignedReviewers,
-			MergedAt:          pr.MergedAt,
-			CreatedAt:         pr.CreatedAt,
-			Status:            api.PullRequestStatus(pr.Status),
-		},
+		Pr: toAPIPullRequest(pr),
 	}, nil
 }
 
 func (h *Handlers) PostPullRequestReassign(ctx context.Context, request api.PostPullRequestReassignRequestObject) (api.PostPullRequestReassignResponseObject, error) {
-	pr, replacedBy, err := h.svc.PullRequestReassign(ctx, request.Body.PullRequestId, request.Body.OldUserId)
+	pr, replacedBy, err := h.svc.PullRequestReassign(ctx, request.Body.PullRequestId, request.Body.OldUserId, stringValue(request.Body.ActorId))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrPRNotFound), errors.Is(err, domain.ErrUserNotFound):
@@ -113,24 +168,148 @@ func (h *Handlers) PostPullRequestReassign(ctx context.Context, request api.Post
 			return api.PostPullRequestReassign409JSONResponse(
 				errorResponse(api.ErrorResponseErrorCodeNOCANDIDATE, "no active replacement candidate in team"),
 			), nil
+		case errors.Is(err, domain.ErrCapacityExhausted):
+			return api.PostPullRequestReassign409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeCAPACITYEXHAUSTED, "all candidates reached their open review limit"),
+			), nil
+		case errors.Is(err, domain.ErrTagsNotCovered):
+			return api.PostPullRequestReassign409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeTAGSNOTCOVERED, errorText(err)),
+			), nil
 		}
 		return nil, err
 	}
 
 	return api.PostPullRequestReassign200JSONResponse{
-		Pr: api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			AssignedReviewers: pr.AssignedReviewers,
-			MergedAt:          pr.MergedAt,
-			CreatedAt:         pr.CreatedAt,
-			Status:            api.PullRequestStatus(pr.Status),
-		},
+		Pr:         *toAPIPullRequest(pr),
 		ReplacedBy: replacedBy,
 	}, nil
 }
 
+func (h *Handlers) PostPullRequestReady(ctx context.Context, request api.PostPullRequestReadyRequestObject) (api.PostPullRequestReadyResponseObject, error) {
+	pr, err := h.svc.PullRequestReady(ctx, request.Body.PullRequestId, stringValue(request.Body.ActorId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPRNotFound):
+			return api.PostPullRequestReady404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return api.PostPullRequestReady409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTRANSITION, "only draft PR can be marked ready"),
+			), nil
+		case errors.Is(err, domain.ErrNoCandidate):
+			return api.PostPullRequestReady409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOCANDIDATE, "not enough reviewers for team policy"),
+			), nil
+		case errors.Is(err, domain.ErrCapacityExhausted):
+			return api.PostPullRequestReady409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeCAPACITYEXHAUSTED, "all candidates reached their open review limit"),
+			), nil
+		case errors.Is(err, domain.ErrTagsNotCovered):
+			return api.PostPullRequestReady409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeTAGSNOTCOVERED, errorText(err)),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostPullRequestReady200JSONResponse{
+		Pr: *toAPIPullRequest(pr),
+	}, nil
+}
+
+func (h *Handlers) PostPullRequestClose(ctx context.Context, request api.PostPullRequestCloseRequestObject) (api.PostPullRequestCloseResponseObject, error) {
+	pr, err := h.svc.PullRequestClose(ctx, request.Body.PullRequestId, stringValue(request.Body.ActorId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPRNotFound):
+			return api.PostPullRequestClose404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return api.PostPullRequestClose409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTRANSITION, "merged PR cannot be closed"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostPullRequestClose200JSONResponse{
+		Pr: *toAPIPullRequest(pr),
+	}, nil
+}
+
+func (h *Handlers) PostPullRequestReopen(ctx context.Context, request api.PostPullRequestReopenRequestObject) (api.PostPullRequestReopenResponseObject, error) {
+	pr, err := h.svc.PullRequestReopen(ctx, request.Body.PullRequestId, stringValue(request.Body.ActorId))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPRNotFound):
+			return api.PostPullRequestReopen404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return api.PostPullRequestReopen409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTRANSITION, "only closed PR can be reopened"),
+			), nil
+		case errors.Is(err, domain.ErrNoCandidate):
+			return api.PostPullRequestReopen409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOCANDIDATE, "not enough reviewers for team policy"),
+			), nil
+		case errors.Is(err, domain.ErrCapacityExhausted):
+			return api.PostPullRequestReopen409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeCAPACITYEXHAUSTED, "all candidates reached their open review limit"),
+			), nil
+		case errors.Is(err, domain.ErrTagsNotCovered):
+			return api.PostPullRequestReopen409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeTAGSNOTCOVERED, errorText(err)),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostPullRequestReopen200JSONResponse{
+		Pr: *toAPIPullRequest(pr),
+	}, nil
+}
+
+func (h *Handlers) PostPullRequestReview(ctx context.Context, request api.PostPullRequestReviewRequestObject) (api.PostPullRequestReviewResponseObject, error) {
+	pr, err := h.svc.PullRequestReview(ctx,
+		request.Body.PullRequestId,
+		request.Body.ReviewerId,
+		domain.ReviewState(request.Body.State),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidReviewState):
+			return api.PostPullRequestReview400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDREVIEWSTATE, "state must be APPROVED, CHANGES_REQUESTED or COMMENTED"),
+			), nil
+		case errors.Is(err, domain.ErrPRNotFound):
+			return api.PostPullRequestReview404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found"),
+			), nil
+		case errors.Is(err, domain.ErrPRMerged):
+			return api.PostPullRequestReview409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodePRMERGED, "cannot review merged PR"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return api.PostPullRequestReview409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTRANSITION, "only open PR can be reviewed"),
+			), nil
+		case errors.Is(err, domain.ErrNotAssigned):
+			return api.PostPullRequestReview409JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTASSIGNED, "reviewer is not assigned to PR"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostPullRequestReview200JSONResponse{
+		Pr: *toAPIPullRequest(pr),
+	}, nil
+}
+
 func (h *Handlers) PostUsersSetIsActive(ctx context.Context, request api.PostUsersSetIsActiveRequestObject) (api.PostUsersSetIsActiveResponseObject, error) {
 	user, err := h.svc.SetUserActive(ctx, request.Body.UserId, request.Body.IsActive)
 	if err != nil {
@@ -143,32 +322,217 @@ func (h *Handlers) PostUsersSetIsActive(ctx context.Context, request api.PostUse
 	}
 
 	return api.PostUsersSetIsActive200JSONResponse{
-		User: &api.User{
-			UserId:   user.ID,
-			Username: user.Name,
-			TeamName: user.TeamName,
-			IsActive: user.IsActive,
-		},
+		User: toAPIUser(user),
 	}, nil
 }
 
+func (h *Handlers) PostUsersSetMaxOpenReviews(ctx context.Context, request api.PostUsersSetMaxOpenReviewsRequestObject) (api.PostUsersSetMaxOpenReviewsResponseObject, error) {
+	user, err := h.svc.UserSetMaxOpenReviews(ctx, request.Body.UserId, request.Body.MaxOpenReviews)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidCapacity):
+			return api.PostUsersSetMaxOpenReviews400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDCAPACITY, "review limit must be positive"),
+			), nil
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.PostUsersSetMaxOpenReviews404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersSetMaxOpenReviews200JSONResponse{
+		User: toAPIUser(user),
+	}, nil
+}
+
+func (h *Handlers) PostUsersSetTags(ctx context.Context, request api.PostUsersSetTagsRequestObject) (api.PostUsersSetTagsResponseObject, error) {
+	user, err := h.svc.UserSetTags(ctx, request.Body.UserId, request.Body.Tags)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTag):
+			return api.PostUsersSetTags400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTAG, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.PostUsersSetTags404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersSetTags200JSONResponse{
+		User: toAPIUser(user),
+	}, nil
+}
+
+func (h *Handlers) PostUsersSetEmail(ctx context.Context, request api.PostUsersSetEmailRequestObject) (api.PostUsersSetEmailResponseObject, error) {
+	user, err := h.svc.UserSetEmail(ctx, request.Body.UserId, request.Body.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidEmail):
+			return api.PostUsersSetEmail400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDEMAIL, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.PostUsersSetEmail404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersSetEmail200JSONResponse{
+		User: toAPIUser(user),
+	}, nil
+}
+
+func toAPIUser(user *domain.User) *api.User {
+	res := &api.User{
+		UserId:         user.ID,
+		Username:       user.Name,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+		Email:          optionalString(user.Email),
+	}
+	if user.Tags != nil {
+		res.Tags = &user.Tags
+	}
+	return res
+}
+
+func (h *Handlers) PostUsersAddUnavailability(ctx context.Context, request api.PostUsersAddUnavailabilityRequestObject) (api.PostUsersAddUnavailabilityResponseObject, error) {
+	period, reassigned, err := h.svc.UserAddUnavailability(ctx, domain.Unavailability{
+		UserID:   request.Body.UserId,
+		StartsAt: request.Body.StartsAt,
+		EndsAt:   request.Body.EndsAt,
+		Reason:   stringValue(request.Body.Reason),
+	}, request.Body.ReassignOpenReviews != nil && *request.Body.ReassignOpenReviews)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPeriod):
+			return api.PostUsersAddUnavailability400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPERIOD, "ends_at must be after starts_at"),
+			), nil
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.PostUsersAddUnavailability404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersAddUnavailability201JSONResponse{
+		Period:          toAPIUnavailability(*period),
+		ReassignedCount: reassigned,
+	}, nil
+}
+
+func (h *Handlers) GetUsersGetUnavailability(ctx context.Context, request api.GetUsersGetUnavailabilityRequestObject) (api.GetUsersGetUnavailabilityResponseObject, error) {
+	periods, err := h.svc.UserListUnavailability(ctx, request.Params.UserId)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return api.GetUsersGetUnavailability404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.Unavailability, 0, len(periods))
+	for _, p := range periods {
+		res = append(res, toAPIUnavailability(p))
+	}
+
+	return api.GetUsersGetUnavailability200JSONResponse{
+		UserId:  request.Params.UserId,
+		Periods: res,
+	}, nil
+}
+
+func (h *Handlers) PostUsersUpdateUnavailability(ctx context.Context, request api.PostUsersUpdateUnavailabilityRequestObject) (api.PostUsersUpdateUnavailabilityResponseObject, error) {
+	period, err := h.svc.UserUpdateUnavailability(ctx, domain.Unavailability{
+		ID:       request.Body.Id,
+		StartsAt: request.Body.StartsAt,
+		EndsAt:   request.Body.EndsAt,
+		Reason:   stringValue(request.Body.Reason),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPeriod):
+			return api.PostUsersUpdateUnavailability400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPERIOD, "ends_at must be after starts_at"),
+			), nil
+		case errors.Is(err, domain.ErrUnavailabilityNotFound):
+			return api.PostUsersUpdateUnavailability404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "unavailability period not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersUpdateUnavailability200JSONResponse{
+		Period: toAPIUnavailability(*period),
+	}, nil
+}
+
+func (h *Handlers) PostUsersDeleteUnavailability(ctx context.Context, request api.PostUsersDeleteUnavailabilityRequestObject) (api.PostUsersDeleteUnavailabilityResponseObject, error) {
+	if err := h.svc.UserDeleteUnavailability(ctx, request.Body.Id); err != nil {
+		if errors.Is(err, domain.ErrUnavailabilityNotFound) {
+			return api.PostUsersDeleteUnavailability404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "unavailability period not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostUsersDeleteUnavailability204Response{}, nil
+}
+
+func toAPIUnavailability(p domain.Unavailability) api.Unavailability {
+	return api.Unavailability{
+		Id:       p.ID,
+		UserId:   p.UserID,
+		StartsAt: p.StartsAt,
+		EndsAt:   p.EndsAt,
+		Reason:   p.Reason,
+	}
+}
+
 func (h *Handlers) PostTeamAdd(ctx context.Context, request api.PostTeamAddRequestObject) (api.PostTeamAddResponseObject, error) {
 	dMembers := []domain.User{}
 	for _, m := range request.Body.Members {
-		dMembers = append(dMembers, domain.User{
+		member := domain.User{
 			ID:       m.UserId,
 			Name:     m.Username,
 			IsActive: m.IsActive,
 			TeamName: request.Body.TeamName,
-		})
+		}
+		if m.Tags != nil {
+			member.Tags = *m.Tags
+		}
+		member.Email = stringValue(m.Email)
+		dMembers = append(dMembers, member)
 	}
 
 	team, err := h.svc.TeamAdd(ctx, request.Body.TeamName, dMembers)
 	if err != nil {
-		if errors.Is(err, domain.ErrTeamExists) {
+		switch {
+		case errors.Is(err, domain.ErrTeamExists):
 			return api.PostTeamAdd400JSONResponse(
 				errorResponse(api.ErrorResponseErrorCodeTEAMEXISTS, "team already exists"),
 			), nil
+		case errors.Is(err, domain.ErrInvalidTag):
+			return api.PostTeamAdd400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDTAG, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrInvalidEmail):
+			return api.PostTeamAdd400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDEMAIL, errorText(err)),
+			), nil
 		}
 		return nil, err
 	}
@@ -179,6 +543,8 @@ func (h *Handlers) PostTeamAdd(ctx context.Context, request api.PostTeamAddReque
 			IsActive: m.IsActive,
 			UserId:   m.ID,
 			Username: m.Name,
+			Tags:     &m.Tags,
+			Email:    optionalString(m.Email),
 		})
 	}
 
@@ -207,6 +573,8 @@ func (h *Handlers) GetTeamGet(ctx context.Context, request api.GetTeamGetRequest
 			IsActive: m.IsActive,
 			UserId:   m.ID,
 			Username: m.Name,
+			Tags:     &m.Tags,
+			Email:    optionalString(m.Email),
 		})
 	}
 
@@ -216,6 +584,78 @@ func (h *Handlers) GetTeamGet(ctx context.Context, request api.GetTeamGetRequest
 	}, nil
 }
 
+func (h *Handlers) PostTeamSetPolicy(ctx context.Context, request api.PostTeamSetPolicyRequestObject) (api.PostTeamSetPolicyResponseObject, error) {
+	policy, err := h.svc.TeamSetPolicy(ctx, domain.TeamPolicy{
+		TeamName:         request.Body.TeamName,
+		MinReviewers:     request.Body.MinReviewers,
+		MaxReviewers:     request.Body.MaxReviewers,
+		AssignOnlyActive: request.Body.AssignOnlyActive,
+		DefaultCapacity:  intValue(request.Body.DefaultCapacity),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPolicy):
+			return api.PostTeamSetPolicy400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPOLICY, "min_reviewers must be in [0, max_reviewers] and default_capacity non-negative"),
+			), nil
+		case errors.Is(err, domain.ErrTeamNotFound):
+			return api.PostTeamSetPolicy404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostTeamSetPolicy200JSONResponse{
+		Policy: api.TeamPolicy{
+			TeamName:         policy.TeamName,
+			MinReviewers:     policy.MinReviewers,
+			MaxReviewers:     policy.MaxReviewers,
+			AssignOnlyActive: policy.AssignOnlyActive,
+			DefaultCapacity:  &policy.DefaultCapacity,
+		},
+	}, nil
+}
+
+func (h *Handlers) PostTeamSetFallbacks(ctx context.Context, request api.PostTeamSetFallbacksRequestObject) (api.PostTeamSetFallbacksResponseObject, error) {
+	fallbacks, err := h.svc.TeamSetFallbacks(ctx, request.Body.TeamName, request.Body.FallbackTeams)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidFallback):
+			return api.PostTeamSetFallbacks400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDFALLBACK, "fallback chain must not contain the team itself or duplicates"),
+			), nil
+		case errors.Is(err, domain.ErrTeamNotFound):
+			return api.PostTeamSetFallbacks404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostTeamSetFallbacks200JSONResponse{
+		TeamName:      request.Body.TeamName,
+		FallbackTeams: fallbacks,
+	}, nil
+}
+
+func (h *Handlers) GetTeamGetFallbacks(ctx context.Context, request api.GetTeamGetFallbacksRequestObject) (api.GetTeamGetFallbacksResponseObject, error) {
+	fallbacks, err := h.svc.TeamGetFallbacks(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			return api.GetTeamGetFallbacks404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamGetFallbacks200JSONResponse{
+		TeamName:      request.Params.TeamName,
+		FallbackTeams: fallbacks,
+	}, nil
+}
+
 // package handlers
 
 func (h *Handlers) PostTeamDeactivateUsers(
@@ -237,15 +677,7 @@ func (h *Handlers) PostTeamDeactivateUsers(
 
 	updatedPRs := []api.PullRequest{}
 	for _, pr := range updatedPRsDomain {
-		updatedPRs = append(updatedPRs, api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			AssignedReviewers: pr.AssignedReviewers,
-			MergedAt:          pr.MergedAt,
-			CreatedAt:         pr.CreatedAt,
-			Status:            api.PullRequestStatus(pr.Status),
-		})
+		updatedPRs = append(updatedPRs, *toAPIPullRequest(pr))
 	}
 
 	return api.PostTeamDeactivateUsers200JSONResponse{
@@ -262,8 +694,32 @@ func (h *Handlers) GetUsersGetReview(
 	request api.GetUsersGetReviewRequestObject,
 ) (api.GetUsersGetReviewResponseObject, error) {
 
-	prs, err := h.svc.UsersGetReview(ctx, request.Params.UserId)
+	q := domain.ReviewInboxQuery{
+		UserID:      request.Params.UserId,
+		PendingOnly: request.Params.PendingOnly != nil && *request.Params.PendingOnly,
+		Ascending:   request.Params.Order != nil && *request.Params.Order == api.GetUsersGetReviewParamsOrderAsc,
+		Limit:       intValue(request.Params.Limit),
+	}
+	if request.Params.Status != nil {
+		q.Status = domain.PullRequestStatus(*request.Params.Status)
+	}
+
+	prs, next, err := h.svc.UsersGetReview(ctx, q, stringValue(request.Params.Cursor))
 	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.GetUsersGetReview404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidStatusFilter):
+			return api.GetUsersGetReview400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDFILTER, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrInvalidCursor):
+			return api.GetUsersGetReview400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDCURSOR, errorText(err)),
+			), nil
+		}
 		return nil, fmt.Errorf("cannot get reviews: %w", err)
 	}
 
@@ -274,12 +730,14 @@ func (h *Handlers) GetUsersGetReview(
 			PullRequestId:   pr.PullRequestId,
 			PullRequestName: pr.PullRequestName,
 			Status:          api.PullRequestShortStatus(pr.Status),
+			CreatedAt:       pr.CreatedAt,
 		})
 	}
 
 	return api.GetUsersGetReview200JSONResponse{
 		UserId:       request.Params.UserId,
 		PullRequests: resp,
+		NextCursor:   optionalString(next),
 	}, nil
 }
 
@@ -287,17 +745,40 @@ func (h *Handlers) GetStats(
 	ctx context.Context,
 	request api.GetStatsRequestObject,
 ) (api.GetStatsResponseObject, error) {
-	byUserDomain, byPRDomain, err := h.svc.GetAssignmentStats(ctx)
+	filter := domain.StatsFilter{
+		From:     request.Params.From,
+		To:       request.Params.To,
+		TeamName: stringValue(request.Params.TeamName),
+	}
+	if request.Params.Status != nil {
+		filter.Status = domain.PullRequestStatus(*request.Params.Status)
+	}
+
+	stats, err := h.svc.GetAssignmentStats(ctx, filter)
 	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidStatsWindow):
+			return api.GetStats400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPERIOD, "to must be after from"),
+			), nil
+		case errors.Is(err, domain.ErrInvalidStatusFilter):
+			return api.GetStats400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDFILTER, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrTeamNotFound):
+			return api.GetStats404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
 		return nil, fmt.Errorf("cannot get assignment stats: %w", err)
 	}
 
 	apiByUser := make([]struct {
 		AssignmentsCount int    `json:"assignments_count"`
 		UserId           string `json:"user_id"`
-	}, len(byUserDomain))
+	}, len(stats.ByUser))
 
-	for i, item := range byUserDomain {
+	for i, item := range stats.ByUser {
 		apiByUser[i] = struct {
 			AssignmentsCount int    `json:"assignments_count"`
 			UserId           string `json:"user_id"`
@@ -310,9 +791,9 @@ func (h *Handlers) GetStats(
 	apiByPR := make([]struct {
 		PullRequestId  string `json:"pull_request_id"`
 		ReviewersCount int    `json:"reviewers_count"`
-	}, len(byPRDomain))
+	}, len(stats.ByPR))
 
-	for i, pr := range byPRDomain {
+	for i, pr := range stats.ByPR {
 		apiByPR[i] = struct {
 			PullRequestId  string `json:"pull_request_id"`
 			ReviewersCount int    `json:"reviewers_count"`
@@ -322,14 +803,416 @@ func (h *Handlers) GetStats(
 		}
 	}
 
+	apiByTeam := make([]struct {
+		AssignmentsCount  int    `json:"assignments_count"`
+		PullRequestsCount int    `json:"pull_requests_count"`
+		TeamName          string `json:"team_name"`
+	}, len(stats.ByTeam))
+
+	for i, team := range stats.ByTeam {
+		apiByTeam[i] = struct {
+			AssignmentsCount  int    `json:"assignments_count"`
+			PullRequestsCount int    `json:"pull_requests_count"`
+			TeamName          string `json:"team_name"`
+		}{
+			AssignmentsCount:  team.AssignmentsCount,
+			PullRequestsCount: team.PullRequestsCount,
+			TeamName:          team.TeamName,
+		}
+	}
+
+	apiByDay := make([]struct {
+		AssignmentsCount  int    `json:"assignments_count"`
+		Day               string `json:"day"`
+		PullRequestsCount int    `json:"pull_requests_count"`
+	}, len(stats.ByDay))
+
+	for i, day := range stats.ByDay {
+		apiByDay[i] = struct {
+			AssignmentsCount  int    `json:"assignments_count"`
+			Day               string `json:"day"`
+			PullRequestsCount int    `json:"pull_requests_count"`
+		}{
+			AssignmentsCount:  day.AssignmentsCount,
+			Day:               day.Day.Format(time.DateOnly),
+			PullRequestsCount: day.PullRequestsCount,
+		}
+	}
+
 	return api.GetStats200JSONResponse{
 		ByUser: &apiByUser,
 		ByPr:   &apiByPR,
+		ByTeam: &apiByTeam,
+		ByDay:  &apiByDay,
+	}, nil
+}
+
+func (h *Handlers) GetStatsLatency(ctx context.Context, request api.GetStatsLatencyRequestObject) (api.GetStatsLatencyResponseObject, error) {
+	stats, err := h.svc.StatsLatency(ctx, request.Params.From, request.Params.To)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidStatsWindow) {
+			return api.GetStatsLatency400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPERIOD, "to must be after from"),
+			), nil
+		}
+		return nil, fmt.Errorf("cannot get latency stats: %w", err)
+	}
+
+	byUser := make([]api.UserLatency, 0, len(stats.ByUser))
+	for _, u := range stats.ByUser {
+		byUser = append(byUser, api.UserLatency{
+			UserId:      u.UserID,
+			TeamName:    u.TeamName,
+			FirstReview: toAPILatencySummary(u.FirstReview),
+			Merge:       toAPILatencySummary(u.Merge),
+		})
+	}
+
+	byTeam := make([]api.TeamLatency, 0, len(stats.ByTeam))
+	for _, t := range stats.ByTeam {
+		byTeam = append(byTeam, api.TeamLatency{
+			TeamName:    t.TeamName,
+			FirstReview: toAPILatencySummary(t.FirstReview),
+			Merge:       toAPILatencySummary(t.Merge),
+		})
+	}
+
+	return api.GetStatsLatency200JSONResponse{
+		From:   stats.From,
+		To:     stats.To,
+		ByUser: byUser,
+		ByTeam: byTeam,
+	}, nil
+}
+
+func (h *Handlers) GetStatsFairness(ctx context.Context, request api.GetStatsFairnessRequestObject) (api.GetStatsFairnessResponseObject, error) {
+	report, err := h.svc.StatsFairness(ctx, stringValue(request.Params.TeamName), request.Params.From, request.Params.To)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidStatsWindow):
+			return api.GetStatsFairness400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDPERIOD, "to must be after from"),
+			), nil
+		case errors.Is(err, domain.ErrTeamNotFound):
+			return api.GetStatsFairness404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
+		return nil, fmt.Errorf("cannot get fairness report: %w", err)
+	}
+
+	teams := make([]api.TeamFairness, 0, len(report.Teams))
+	for _, t := range report.Teams {
+		teams = append(teams, api.TeamFairness{
+			TeamName:         t.TeamName,
+			MembersCount:     t.MembersCount,
+			AssignmentsCount: t.AssignmentsCount,
+			Mean:             t.Mean,
+			Gini:             t.Gini,
+			MaxMinRatio:      t.MaxMinRatio,
+			Threshold:        t.Threshold,
+			Skewed:           t.Skewed,
+			OverAssigned:     toAPIMemberAssignments(t.OverAssigned),
+			UnderAssigned:    toAPIMemberAssignments(t.UnderAssigned),
+		})
+	}
+
+	return api.GetStatsFairness200JSONResponse{
+		From:  report.From,
+		To:    report.To,
+		Teams: teams,
+	}, nil
+}
+
+func (h *Handlers) PostCodeownersSet(ctx context.Context, request api.PostCodeownersSetRequestObject) (api.PostCodeownersSetResponseObject, error) {
+	rules, err := h.svc.CodeOwnersSet(ctx, request.Body.Content)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCodeOwners) {
+			return api.PostCodeownersSet400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDCODEOWNERS, errorText(err)),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostCodeownersSet200JSONResponse{
+		Rules: toAPICodeOwnerRules(rules),
+	}, nil
+}
+
+func (h *Handlers) GetCodeownersGet(ctx context.Context, request api.GetCodeownersGetRequestObject) (api.GetCodeownersGetResponseObject, error) {
+	rules, err := h.svc.CodeOwnersGet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetCodeownersGet200JSONResponse{
+		Rules: toAPICodeOwnerRules(rules),
+	}, nil
+}
+
+func toAPICodeOwnerRules(rules []domain.CodeOwnerRule) []api.CodeOwnerRule {
+	res := make([]api.CodeOwnerRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, api.CodeOwnerRule{
+			Pattern: r.Pattern,
+			Users:   append([]string{}, r.Users...),
+			Teams:   append([]string{}, r.Teams...),
+		})
+	}
+	return res
+}
+
+func (h *Handlers) GetPullRequestHistory(ctx context.Context, request api.GetPullRequestHistoryRequestObject) (api.GetPullRequestHistoryResponseObject, error) {
+	events, err := h.svc.PullRequestHistory(ctx, request.Params.PullRequestId)
+	if err != nil {
+		if errors.Is(err, domain.ErrPRNotFound) {
+			return api.GetPullRequestHistory404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "PR not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.GetPullRequestHistory200JSONResponse{
+		PullRequestId: request.Params.PullRequestId,
+		Events:        toAPIAssignmentEvents(events),
+	}, nil
+}
+
+func (h *Handlers) GetUsersHistory(ctx context.Context, request api.GetUsersHistoryRequestObject) (api.GetUsersHistoryResponseObject, error) {
+	events, err := h.svc.UserHistory(ctx, request.Params.UserId)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return api.GetUsersHistory404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.GetUsersHistory200JSONResponse{
+		UserId: request.Params.UserId,
+		Events: toAPIAssignmentEvents(events),
+	}, nil
+}
+
+func toAPIAssignmentEvents(events []domain.AssignmentEvent) []api.AssignmentEvent {
+	res := make([]api.AssignmentEvent, 0, len(events))
+	for _, e := range events {
+		res = append(res, api.AssignmentEvent{
+			Id:            e.ID,
+			PullRequestId: e.PullRequestID,
+			EventType:     api.AssignmentEventEventType(e.Type),
+			ActorId:       optionalString(e.ActorID),
+			OldReviewerId: optionalString(e.OldReviewerID),
+			NewReviewerId: optionalString(e.NewReviewerID),
+			Reason:        e.Reason,
+			Strategy:      e.Strategy,
+			CreatedAt:     e.CreatedAt,
+		})
+	}
+	return res
+}
+
+func (h *Handlers) PostWebhooksCreate(ctx context.Context, request api.PostWebhooksCreateRequestObject) (api.PostWebhooksCreateResponseObject, error) {
+	sub := domain.WebhookSubscription{
+		URL:      request.Body.Url,
+		Secret:   stringValue(request.Body.Secret),
+		TeamName: request.Body.TeamName,
+	}
+	if request.Body.Events != nil {
+		for _, e := range *request.Body.Events {
+			sub.Events = append(sub.Events, domain.OutboxEventType(e))
+		}
+	}
+
+	created, err := h.svc.WebhookCreate(ctx, sub)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidWebhook):
+			return api.PostWebhooksCreate400JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeINVALIDWEBHOOK, errorText(err)),
+			), nil
+		case errors.Is(err, domain.ErrTeamNotFound):
+			return api.PostWebhooksCreate404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "team not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostWebhooksCreate201JSONResponse{
+		Subscription: toAPIWebhookSubscription(*created),
+		Secret:       created.Secret,
 	}, nil
 }
 
+func (h *Handlers) GetWebhooksList(ctx context.Context, request api.GetWebhooksListRequestObject) (api.GetWebhooksListResponseObject, error) {
+	subs, err := h.svc.WebhookList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.WebhookSubscription, 0, len(subs))
+	for _, s := range subs {
+		res = append(res, toAPIWebhookSubscription(s))
+	}
+	return api.GetWebhooksList200JSONResponse{Subscriptions: res}, nil
+}
+
+func (h *Handlers) PostWebhooksDelete(ctx context.Context, request api.PostWebhooksDeleteRequestObject) (api.PostWebhooksDeleteResponseObject, error) {
+	if err := h.svc.WebhookDelete(ctx, request.Body.Id); err != nil {
+		if errors.Is(err, domain.ErrWebhookNotFound) {
+			return api.PostWebhooksDelete404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "webhook subscription not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostWebhooksDelete204Response{}, nil
+}
+
+func (h *Handlers) GetWebhooksDeliveries(ctx context.Context, request api.GetWebhooksDeliveriesRequestObject) (api.GetWebhooksDeliveriesResponseObject, error) {
+	deliveries, err := h.svc.WebhookDeliveries(ctx, request.Params.SubscriptionId, intValue(request.Params.Limit))
+	if err != nil {
+		if errors.Is(err, domain.ErrWebhookNotFound) {
+			return api.GetWebhooksDeliveries404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "webhook subscription not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, toAPIWebhookDelivery(d))
+	}
+	return api.GetWebhooksDeliveries200JSONResponse{Deliveries: res}, nil
+}
+
+func (h *Handlers) PostWebhooksRedeliver(ctx context.Context, request api.PostWebhooksRedeliverRequestObject) (api.PostWebhooksRedeliverResponseObject, error) {
+	delivery, err := h.svc.WebhookRedeliver(ctx, request.Body.DeliveryId)
+	if err != nil {
+		if errors.Is(err, domain.ErrWebhookDeliveryNotFound) || errors.Is(err, domain.ErrWebhookNotFound) {
+			return api.PostWebhooksRedeliver404JSONResponse(
+				errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "webhook delivery not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	return api.PostWebhooksRedeliver200JSONResponse{Delivery: toAPIWebhookDelivery(*delivery)}, nil
+}
+
+func toAPIWebhookSubscription(s domain.WebhookSubscription) api.WebhookSubscription {
+	events := make([]api.WebhookEvent, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, api.WebhookEvent(e))
+	}
+	return api.WebhookSubscription{
+		Id:        s.ID,
+		Url:       s.URL,
+		Events:    events,
+		TeamName:  s.TeamName,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func toAPIWebhookDelivery(d domain.WebhookDelivery) api.WebhookDelivery {
+	return api.WebhookDelivery{
+		Id:             d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.EventID,
+		EventType:      api.WebhookEvent(d.EventType),
+		Status:         api.WebhookDeliveryStatus(d.Status),
+		ResponseCode:   d.ResponseCode,
+		Error:          optionalString(d.Error),
+		Attempts:       d.Attempts,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
 // вспомогательные функции:
 
+func toAPIMemberAssignments(members []domain.MemberAssignments) []api.MemberAssignments {
+	res := make([]api.MemberAssignments, 0, len(members))
+	for _, m := range members {
+		res = append(res, api.MemberAssignments{UserId: m.UserID, AssignmentsCount: m.AssignmentsCount})
+	}
+	return res
+}
+
+// toAPILatencySummary возвращает null вместо перцентилей, если наблюдений не было
+func toAPILatencySummary(s domain.LatencySummary) api.LatencySummary {
+	res := api.LatencySummary{Count: s.Count}
+	if s.Count > 0 {
+		median, p90 := s.Median.Seconds(), s.P90.Seconds()
+		res.MedianSeconds, res.P90Seconds = &median, &p90
+	}
+	return res
+}
+
+func toAPIPullRequest(pr *domain.PullRequest) *api.PullRequest {
+	reviewers := make([]api.Reviewer, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		reviewers = append(reviewers, api.Reviewer{
+			UserId:         r.UserID,
+			TeamName:       r.TeamName,
+			State:          api.ReviewState(r.State),
+			StateUpdatedAt: r.StateUpdatedAt,
+			AssignedAt:     r.AssignedAt,
+			RespondedAt:    r.RespondedAt,
+		})
+	}
+
+	return &api.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		AssignedReviewers: pr.AssignedReviewers,
+		Reviewers:         reviewers,
+		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
+		CreatedAt:         pr.CreatedAt,
+		Status:            api.PullRequestStatus(pr.Status),
+		ChangedPaths:      &pr.ChangedPaths,
+		RequiredTags:      &pr.RequiredTags,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optionalString превращает пустую строку в nil для nullable-полей ответа
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// errorText возвращает текст доменной ошибки без префикса с кодом
+func errorText(err error) string {
+	if _, msg, ok := strings.Cut(err.Error(), ": "); ok {
+		return msg
+	}
+	return err.Error()
+}
+
 func errorResponse(code api.ErrorResponseErrorCode, message string) api.ErrorResponse {
 	return api.ErrorResponse{
 		Error: struct {
@@ -0,0 +1,80 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Gitea — вебхуки pull_request Gitea и Forgejo (Forgejo дублирует заголовки с префиксом X-Gitea-).
+// Подпись — hex HMAC-SHA256 тела без префикса. ID PR в сервисе — "<owner>/<repo>#<number>".
+type Gitea struct{}
+
+func (Gitea) Name() string {
+	return "gitea"
+}
+
+func (Gitea) Verify(header http.Header, body []byte, secret string) bool {
+	sig := header.Get("X-Gitea-Signature")
+	if sig == "" {
+		sig = header.Get("X-Forgejo-Signature")
+	}
+	return sig != "" && validHMAC(sig, body, secret)
+}
+
+type giteaPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string     `json:"title"`
+		Draft  bool       `json:"draft"`
+		Merged bool       `json:"merged"`
+		User   giteaActor `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender giteaActor `json:"sender"`
+}
+
+type giteaActor struct {
+	Login string `json:"login"`
+}
+
+func (Gitea) Parse(header http.Header, body []byte) (*Event, error) {
+	eventType := header.Get("X-Gitea-Event")
+	if eventType == "" {
+		eventType = header.Get("X-Forgejo-Event")
+	}
+	if eventType != "pull_request" {
+		return nil, nil
+	}
+
+	var p giteaPullRequestEvent
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		PullRequestID: fmt.Sprintf("%s#%d", p.Repository.FullName, p.Number),
+		Title:         p.PullRequest.Title,
+		Draft:         p.PullRequest.Draft,
+		AuthorLogin:   p.PullRequest.User.Login,
+		ActorLogin:    p.Sender.Login,
+	}
+
+	switch p.Action {
+	case "opened":
+		event.Action = ActionOpened
+	case "reopened":
+		event.Action = ActionReopened
+	case "closed":
+		event.Action = ActionClosed
+		if p.PullRequest.Merged {
+			event.Action = ActionMerged
+		}
+	default:
+		return nil, nil
+	}
+	return event, nil
+}
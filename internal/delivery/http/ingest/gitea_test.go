@@ -0,0 +1,103 @@
+package ingest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func deliverGitea(t *testing.T, svc *fakeService, fixture string, headers map[string]string) *http.Response {
+	t.Helper()
+	body := loadProviderFixture(t, "gitea", fixture)
+	if headers == nil {
+		headers = map[string]string{
+			"X-Gitea-Event":     "pull_request",
+			"X-Gitea-Signature": strings.TrimPrefix(sign(body, testSecret), "sha256="),
+		}
+	}
+	rec := deliverTo(newProviderRouter(t, svc, Gitea{}), "gitea", body, headers)
+	return rec.Result()
+}
+
+func TestGiteaFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		call    string
+		actorId string
+		action  string
+	}{
+		{fixture: "opened.json", call: "create", actorId: "u1", action: "opened"},
+		{fixture: "closed_merged.json", call: "merge", actorId: "u2", action: "merged"},
+		{fixture: "closed.json", call: "close", actorId: "u2", action: "closed"},
+		{fixture: "reopened.json", call: "reopen", actorId: "u2", action: "reopened"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			svc := &fakeService{}
+
+			resp := deliverGitea(t, svc, tt.fixture, nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+			if len(svc.calls) != 1 || svc.calls[0] != tt.call {
+				t.Fatalf("calls = %v, want [%s]", svc.calls, tt.call)
+			}
+			if svc.actorId != tt.actorId {
+				t.Errorf("actor = %q, want %q", svc.actorId, tt.actorId)
+			}
+
+			var res result
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if res.Action != tt.action || res.PullRequestID != "acme/frontend#12" {
+				t.Errorf("response = %+v", res)
+			}
+		})
+	}
+}
+
+func TestGiteaForgejoHeaders(t *testing.T) {
+	svc := &fakeService{}
+	body := loadProviderFixture(t, "gitea", "opened.json")
+
+	resp := deliverGitea(t, svc, "opened.json", map[string]string{
+		"X-Forgejo-Event":     "pull_request",
+		"X-Forgejo-Signature": strings.TrimPrefix(sign(body, testSecret), "sha256="),
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	if len(svc.calls) != 1 || svc.calls[0] != "create" {
+		t.Errorf("calls = %v, want [create]", svc.calls)
+	}
+}
+
+func TestGiteaBadSignatureRejected(t *testing.T) {
+	body := loadProviderFixture(t, "gitea", "opened.json")
+
+	tests := map[string]string{
+		"wrong secret": strings.TrimPrefix(sign(body, "other"), "sha256="),
+		// в отличие от GitHub, Gitea шлёт подпись без префикса
+		"github prefix": sign(body, testSecret),
+		"missing":       "",
+	}
+	for name, signature := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeService{}
+
+			resp := deliverGitea(t, svc, "opened.json", map[string]string{
+				"X-Gitea-Event":     "pull_request",
+				"X-Gitea-Signature": signature,
+			})
+			if resp.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want 401", resp.StatusCode)
+			}
+			if len(svc.calls) != 0 {
+				t.Errorf("service was called: %v", svc.calls)
+			}
+		})
+	}
+}
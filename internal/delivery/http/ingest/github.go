@@ -0,0 +1,79 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GitHub — вебхуки pull_request. ID PR в сервисе — "<owner>/<repo>#<number>".
+type GitHub struct{}
+
+func (GitHub) Name() string {
+	return "github"
+}
+
+func (GitHub) Verify(header http.Header, body []byte, secret string) bool {
+	sig, ok := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	return ok && validHMAC(sig, body, secret)
+}
+
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title    string       `json:"title"`
+		Draft    bool         `json:"draft"`
+		Merged   bool         `json:"merged"`
+		User     githubActor  `json:"user"`
+		MergedBy *githubActor `json:"merged_by"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender githubActor `json:"sender"`
+}
+
+type githubActor struct {
+	Login string `json:"login"`
+}
+
+func (GitHub) Parse(header http.Header, body []byte) (*Event, error) {
+	if header.Get("X-GitHub-Event") != "pull_request" {
+		return nil, nil
+	}
+
+	var p githubPullRequestEvent
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		PullRequestID: fmt.Sprintf("%s#%d", p.Repository.FullName, p.Number),
+		Title:         p.PullRequest.Title,
+		Draft:         p.PullRequest.Draft,
+		AuthorLogin:   p.PullRequest.User.Login,
+		ActorLogin:    p.Sender.Login,
+	}
+
+	switch p.Action {
+	case "opened":
+		event.Action = ActionOpened
+	case "ready_for_review":
+		event.Action = ActionReady
+	case "reopened":
+		event.Action = ActionReopened
+	case "closed":
+		event.Action = ActionClosed
+		if p.PullRequest.Merged {
+			event.Action = ActionMerged
+			if p.PullRequest.MergedBy != nil {
+				event.ActorLogin = p.PullRequest.MergedBy.Login
+			}
+		}
+	default:
+		return nil, nil
+	}
+	return event, nil
+}
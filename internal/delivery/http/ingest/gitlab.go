@@ -0,0 +1,99 @@
+package ingest
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GitLab — Merge Request Hook. GitLab не подписывает тело, а передаёт секрет в X-Gitlab-Token.
+// ID PR в сервисе — "<namespace>/<project>!<iid>".
+type GitLab struct{}
+
+func (GitLab) Name() string {
+	return "gitlab"
+}
+
+func (GitLab) Verify(header http.Header, _ []byte, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), []byte(secret)) == 1
+}
+
+type gitlabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		AuthorID       int64  `json:"author_id"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *gitlabBoolChange `json:"draft"`
+		WorkInProgress *gitlabBoolChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+type gitlabBoolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+func (GitLab) Parse(header http.Header, body []byte) (*Event, error) {
+	if header.Get("X-Gitlab-Event") != "Merge Request Hook" {
+		return nil, nil
+	}
+
+	var p gitlabMergeRequestEvent
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+	if p.ObjectKind != "merge_request" {
+		return nil, nil
+	}
+
+	attrs := p.ObjectAttributes
+	event := &Event{
+		PullRequestID: fmt.Sprintf("%s!%d", p.Project.PathWithNamespace, attrs.IID),
+		Title:         attrs.Title,
+		Draft:         attrs.Draft || attrs.WorkInProgress,
+		ActorLogin:    p.User.Username,
+	}
+	// в хуке нет логина автора MR, только его числовой id: логин известен, лишь когда событие
+	// вызвал сам автор; иначе автор остаётся пустым и MR по этому событию не заводится
+	if p.User.ID == attrs.AuthorID {
+		event.AuthorLogin = p.User.Username
+	}
+
+	switch attrs.Action {
+	case "open":
+		event.Action = ActionOpened
+	case "reopen":
+		event.Action = ActionReopened
+	case "merge":
+		event.Action = ActionMerged
+	case "close":
+		event.Action = ActionClosed
+	case "update":
+		// снятие черновика приходит как update с изменением draft (в старых версиях — work_in_progress)
+		change := p.Changes.Draft
+		if change == nil {
+			change = p.Changes.WorkInProgress
+		}
+		if change == nil || !change.Previous || change.Current {
+			return nil, nil
+		}
+		event.Action = ActionReady
+	default:
+		return nil, nil
+	}
+	return event, nil
+}
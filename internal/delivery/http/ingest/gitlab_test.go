@@ -0,0 +1,100 @@
+package ingest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+func deliverGitLab(t *testing.T, svc *fakeService, fixture, token string) *http.Response {
+	t.Helper()
+	body := loadProviderFixture(t, "gitlab", fixture)
+	rec := deliverTo(newProviderRouter(t, svc, GitLab{}), "gitlab", body, map[string]string{
+		"X-Gitlab-Event": "Merge Request Hook",
+		"X-Gitlab-Token": token,
+	})
+	return rec.Result()
+}
+
+func TestGitLabFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		call    string
+		actorId string
+		action  string
+	}{
+		{fixture: "open.json", call: "create", actorId: "u1", action: "opened"},
+		{fixture: "update_ready.json", call: "ready", actorId: "u1", action: "ready"},
+		// GitLab до 13.x присылает снятие черновика как work_in_progress
+		{fixture: "update_ready_wip.json", call: "ready", actorId: "u1", action: "ready"},
+		{fixture: "merge.json", call: "merge", actorId: "u2", action: "merged"},
+		{fixture: "close.json", call: "close", actorId: "u2", action: "closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			svc := &fakeService{}
+
+			resp := deliverGitLab(t, svc, tt.fixture, testSecret)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+			if len(svc.calls) != 1 || svc.calls[0] != tt.call {
+				t.Fatalf("calls = %v, want [%s]", svc.calls, tt.call)
+			}
+			if svc.actorId != tt.actorId {
+				t.Errorf("actor = %q, want %q", svc.actorId, tt.actorId)
+			}
+
+			var res result
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if res.Action != tt.action || res.PullRequestID != "acme/backend!7" {
+				t.Errorf("response = %+v", res)
+			}
+		})
+	}
+}
+
+func TestGitLabUpdateWithoutDraftChangeIgnored(t *testing.T) {
+	svc := &fakeService{}
+
+	resp := deliverGitLab(t, svc, "update_title.json", testSecret)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	if len(svc.calls) != 0 {
+		t.Errorf("service was called: %v", svc.calls)
+	}
+}
+
+func TestGitLabReopenByOtherUserDoesNotCreatePR(t *testing.T) {
+	svc := &fakeService{err: domain.ErrPRNotFound}
+
+	// MR переоткрыл bob, автор — alice: автора по хуку не узнать, поэтому MR не заводится
+	resp := deliverGitLab(t, svc, "reopen_by_other.json", testSecret)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", resp.StatusCode)
+	}
+	if len(svc.calls) != 1 || svc.calls[0] != "reopen" {
+		t.Errorf("calls = %v, want [reopen]", svc.calls)
+	}
+}
+
+func TestGitLabBadTokenRejected(t *testing.T) {
+	for name, token := range map[string]string{"wrong": "other", "missing": ""} {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeService{}
+
+			if resp := deliverGitLab(t, svc, "open.json", token); resp.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want 401", resp.StatusCode)
+			}
+			if len(svc.calls) != 0 {
+				t.Errorf("service was called: %v", svc.calls)
+			}
+		})
+	}
+}
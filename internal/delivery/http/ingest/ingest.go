@@ -0,0 +1,226 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	api "github.com/3eLLenKa/test-avito/internal/delivery/http/gen"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// maxPayloadSize — ограничение на тело вебхука (GitHub не присылает больше 25 МБ)
+const maxPayloadSize = 25 << 20
+
+type Action string
+
+const (
+	ActionOpened   Action = "opened"
+	ActionReady    Action = "ready"
+	ActionMerged   Action = "merged"
+	ActionClosed   Action = "closed"
+	ActionReopened Action = "reopened"
+)
+
+// Event — событие PR/MR, не зависящее от Git-хостинга. Логины — учётные записи на хостинге,
+// в user_id их переводит Handler по сопоставлению из конфига. Пустой AuthorLogin — хостинг
+// не сообщил автора (например, GitLab, если событие вызвал не автор MR).
+type Event struct {
+	Action        Action
+	PullRequestID string
+	Title         string
+	Draft         bool
+	AuthorLogin   string
+	ActorLogin    string
+}
+
+// Provider разбирает вебхуки конкретного хостинга. Чтобы подключить новый хостинг,
+// достаточно реализовать Provider и зарегистрировать его в app.
+type Provider interface {
+	Name() string
+	// Verify проверяет подлинность запроса по заголовкам и телу
+	Verify(header http.Header, body []byte, secret string) bool
+	// Parse возвращает nil без ошибки, если событие не касается PR
+	Parse(header http.Header, body []byte) (*Event, error)
+}
+
+type Service interface {
+	PullRequestCreate(ctx context.Context, params domain.PullRequestCreateParams) (*domain.PullRequest, error)
+	PullRequestReady(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+	PullRequestRecordExternalMerge(ctx context.Context, prId, actorId, source string) (*domain.PullRequest, error)
+	PullRequestClose(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+	PullRequestReopen(ctx context.Context, prId, actorId string) (*domain.PullRequest, error)
+}
+
+// conflictErrors — ошибки сервиса, которые означают, что событие не удалось применить
+// из-за состояния PR или команды; отдаются как 409 с указанным кодом
+var conflictErrors = []struct {
+	err  error
+	code api.ErrorResponseErrorCode
+}{
+	{domain.ErrInvalidTransition, api.ErrorResponseErrorCodeINVALIDTRANSITION},
+	{domain.ErrNoCandidate, api.ErrorResponseErrorCodeNOCANDIDATE},
+	{domain.ErrCapacityExhausted, api.ErrorResponseErrorCodeCAPACITYEXHAUSTED},
+	{domain.ErrTagsNotCovered, api.ErrorResponseErrorCodeTAGSNOTCOVERED},
+	{domain.ErrInvalidTag, api.ErrorResponseErrorCodeINVALIDTAG},
+}
+
+type Handler struct {
+	log      *slog.Logger
+	svc      Service
+	provider Provider
+	secret   string
+	users    map[string]string
+}
+
+func New(log *slog.Logger, svc Service, provider Provider, cfg config.Integration) (*Handler, error) {
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("%s integration requires a webhook secret", provider.Name())
+	}
+	return &Handler{
+		log:      log,
+		svc:      svc,
+		provider: provider,
+		secret:   cfg.Secret,
+		users:    cfg.Users,
+	}, nil
+}
+
+type result struct {
+	Action        string `json:"action"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+}
+
+func (h *Handler) Handle(c *gin.Context) {
+	// читаем на байт больше лимита, чтобы отличить слишком большое тело от обрезанного
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxPayloadSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "failed to read body"})
+		return
+	}
+	if len(body) > maxPayloadSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"msg": fmt.Sprintf("payload exceeds %d bytes", maxPayloadSize)})
+		return
+	}
+
+	if !h.provider.Verify(c.Request.Header, body, h.secret) {
+		c.JSON(http.StatusUnauthorized, errorResponse(api.ErrorResponseErrorCodeINVALIDSIGNATURE, "signature does not match"))
+		return
+	}
+
+	event, err := h.provider.Parse(c.Request.Header, body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("invalid %s payload: %v", h.provider.Name(), err)})
+		return
+	}
+	if event == nil {
+		c.JSON(http.StatusOK, result{Action: "ignored"})
+		return
+	}
+
+	status, res := h.apply(c.Request.Context(), *event)
+	if status != http.StatusOK {
+		h.log.Warn("ingest: event was not applied",
+			slog.String("provider", h.provider.Name()),
+			slog.String("pr_id", event.PullRequestID),
+			slog.String("action", string(event.Action)),
+			slog.Any("response", res),
+		)
+	}
+	c.JSON(status, res)
+}
+
+func (h *Handler) apply(ctx context.Context, event Event) (int, any) {
+	actorId := h.users[event.ActorLogin]
+
+	var (
+		pr  *domain.PullRequest
+		err error
+	)
+	switch event.Action {
+	case ActionOpened:
+		return h.create(ctx, event, event.Draft)
+	case ActionReady:
+		pr, err = h.svc.PullRequestReady(ctx, event.PullRequestID, actorId)
+	case ActionReopened:
+		pr, err = h.svc.PullRequestReopen(ctx, event.PullRequestID, actorId)
+	case ActionMerged:
+		// merge на хостинге уже произошёл — фиксируем его в обход правил одобрения
+		pr, err = h.svc.PullRequestRecordExternalMerge(ctx, event.PullRequestID, actorId, h.provider.Name())
+	case ActionClosed:
+		pr, err = h.svc.PullRequestClose(ctx, event.PullRequestID, actorId)
+	default:
+		return http.StatusOK, result{Action: "ignored", PullRequestID: event.PullRequestID}
+	}
+
+	if errors.Is(err, domain.ErrPRNotFound) && (event.Action == ActionReady || event.Action == ActionReopened) && event.AuthorLogin != "" {
+		// PR открыт до подключения интеграции — заводим его сразу готовым к ревью.
+		// Без известного автора не заводим: иначе автором стал бы инициатор события.
+		return h.create(ctx, event, false)
+	}
+	if err != nil {
+		return h.fail(err)
+	}
+	return http.StatusOK, result{Action: string(event.Action), PullRequestID: pr.PullRequestId}
+}
+
+func (h *Handler) create(ctx context.Context, event Event, draft bool) (int, any) {
+	authorId, ok := h.users[event.AuthorLogin]
+	if !ok {
+		msg := fmt.Sprintf("no user_id mapped for %s login %q", h.provider.Name(), event.AuthorLogin)
+		return http.StatusUnprocessableEntity, errorResponse(api.ErrorResponseErrorCodeUNKNOWNUSER, msg)
+	}
+
+	pr, err := h.svc.PullRequestCreate(ctx, domain.PullRequestCreateParams{
+		ID:       event.PullRequestID,
+		Name:     event.Title,
+		AuthorID: authorId,
+		Draft:    draft,
+	})
+	if errors.Is(err, domain.ErrPRExists) {
+		// повторная доставка того же события
+		return http.StatusOK, result{Action: "ignored", PullRequestID: event.PullRequestID}
+	}
+	if err != nil {
+		return h.fail(err)
+	}
+	return http.StatusOK, result{Action: string(ActionOpened), PullRequestID: pr.PullRequestId}
+}
+
+func (h *Handler) fail(err error) (int, any) {
+	switch {
+	case errors.Is(err, domain.ErrPRNotFound):
+		return http.StatusNotFound, errorResponse(api.ErrorResponseErrorCodeNOTFOUND, "pull request not found")
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusUnprocessableEntity, errorResponse(api.ErrorResponseErrorCodeUNKNOWNUSER, "mapped user does not exist")
+	}
+	for _, conflict := range conflictErrors {
+		if errors.Is(err, conflict.err) {
+			return http.StatusConflict, errorResponse(conflict.code, errorText(err))
+		}
+	}
+
+	h.log.Error("ingest: failed to apply event", slog.String("provider", h.provider.Name()), slog.Any("error", err))
+	return http.StatusInternalServerError, gin.H{"msg": "failed to apply event"}
+}
+
+// errorText возвращает текст доменной ошибки без префикса с кодом
+func errorText(err error) string {
+	if _, msg, ok := strings.Cut(err.Error(), ": "); ok {
+		return msg
+	}
+	return err.Error()
+}
+
+func errorResponse(code api.ErrorResponseErrorCode, message string) api.ErrorResponse {
+	var res api.ErrorResponse
+	res.Error.Code = code
+	res.Error.Message = message
+	return res
+}
@@ -0,0 +1,254 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const testSecret = "s3cret"
+
+// fakeService запоминает вызовы и отвечает заготовленной ошибкой
+type fakeService struct {
+	calls   []string
+	created *domain.PullRequestCreateParams
+	actorId string
+	source  string
+	err     error
+}
+
+func (f *fakeService) record(call, prId, actorId string) (*domain.PullRequest, error) {
+	f.calls = append(f.calls, call)
+	f.actorId = actorId
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &domain.PullRequest{PullRequestId: prId}, nil
+}
+
+func (f *fakeService) PullRequestCreate(_ context.Context, params domain.PullRequestCreateParams) (*domain.PullRequest, error) {
+	f.created = &params
+	return f.record("create", params.ID, params.AuthorID)
+}
+
+func (f *fakeService) PullRequestReady(_ context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	return f.record("ready", prId, actorId)
+}
+
+func (f *fakeService) PullRequestRecordExternalMerge(_ context.Context, prId, actorId, source string) (*domain.PullRequest, error) {
+	f.source = source
+	return f.record("merge", prId, actorId)
+}
+
+func (f *fakeService) PullRequestClose(_ context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	return f.record("close", prId, actorId)
+}
+
+func (f *fakeService) PullRequestReopen(_ context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	return f.record("reopen", prId, actorId)
+}
+
+func newTestRouter(t *testing.T, svc Service) *gin.Engine {
+	t.Helper()
+	return newProviderRouter(t, svc, GitHub{})
+}
+
+// newProviderRouter регистрирует обработчик на /integrations/<provider>/webhook, как app
+func newProviderRouter(t *testing.T, svc Service, provider Provider) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	h, err := New(slog.New(slog.NewTextHandler(io.Discard, nil)), svc, provider, config.Integration{
+		Enabled: true,
+		Secret:  testSecret,
+		Users:   map[string]string{"alice": "u1", "bob": "u2"},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	router := gin.New()
+	router.POST("/integrations/"+provider.Name()+"/webhook", h.Handle)
+	return router
+}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	return loadProviderFixture(t, "github", name)
+}
+
+func loadProviderFixture(t *testing.T, provider, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", provider, name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliver(router *gin.Engine, body []byte, signature string) *httptest.ResponseRecorder {
+	return deliverTo(router, "github", body, map[string]string{
+		"X-GitHub-Event":      "pull_request",
+		"X-Hub-Signature-256": signature,
+	})
+}
+
+func deliverTo(router *gin.Engine, provider string, body []byte, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/integrations/"+provider+"/webhook", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGitHubFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		call    string
+		actorId string
+		action  string
+	}{
+		{fixture: "opened.json", call: "create", actorId: "u1", action: "opened"},
+		{fixture: "ready_for_review.json", call: "ready", actorId: "u1", action: "ready"},
+		// actor — merged_by, а не sender (бот)
+		{fixture: "closed_merged.json", call: "merge", actorId: "u2", action: "merged"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			svc := &fakeService{}
+			body := loadFixture(t, tt.fixture)
+
+			rec := deliver(newTestRouter(t, svc), body, sign(body, testSecret))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+			}
+			if len(svc.calls) != 1 || svc.calls[0] != tt.call {
+				t.Fatalf("calls = %v, want [%s]", svc.calls, tt.call)
+			}
+			if svc.actorId != tt.actorId {
+				t.Errorf("actor = %q, want %q", svc.actorId, tt.actorId)
+			}
+
+			var res result
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if res.Action != tt.action || res.PullRequestID != "acme/backend#42" {
+				t.Errorf("response = %+v", res)
+			}
+		})
+	}
+}
+
+func TestGitHubOpenedCreatesPR(t *testing.T) {
+	svc := &fakeService{}
+	body := loadFixture(t, "opened.json")
+
+	if rec := deliver(newTestRouter(t, svc), body, sign(body, testSecret)); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	got := svc.created
+	if got == nil || got.ID != "acme/backend#42" || got.Name != "Add search endpoint" || got.AuthorID != "u1" || got.Draft {
+		t.Errorf("created = %+v", got)
+	}
+}
+
+func TestGitHubMergedBypassesApproval(t *testing.T) {
+	svc := &fakeService{}
+	body := loadFixture(t, "closed_merged.json")
+
+	if rec := deliver(newTestRouter(t, svc), body, sign(body, testSecret)); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if svc.source != "github" {
+		t.Errorf("merge source = %q, want github", svc.source)
+	}
+}
+
+func TestGitHubReadyCreatesUnknownPR(t *testing.T) {
+	svc := &fakeService{err: domain.ErrPRNotFound}
+	body := loadFixture(t, "ready_for_review.json")
+
+	rec := deliver(newTestRouter(t, svc), body, sign(body, testSecret))
+	// create тоже получает ErrPRNotFound от фейка, важно лишь, что он был вызван
+	if len(svc.calls) != 2 || svc.calls[1] != "create" {
+		t.Fatalf("calls = %v, want [ready create] (status %d)", svc.calls, rec.Code)
+	}
+}
+
+func TestBadSignatureRejected(t *testing.T) {
+	body := loadFixture(t, "opened.json")
+
+	tests := map[string]string{
+		"wrong secret": sign(body, "other"),
+		"no prefix":    sign(body, testSecret)[len("sha256="):],
+		"not hex":      "sha256=zz",
+		"missing":      "",
+	}
+	for name, signature := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &fakeService{}
+
+			rec := deliver(newTestRouter(t, svc), body, signature)
+			if rec.Code != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want 401", rec.Code)
+			}
+			if len(svc.calls) != 0 {
+				t.Errorf("service was called: %v", svc.calls)
+			}
+		})
+	}
+}
+
+func TestTamperedBodyRejected(t *testing.T) {
+	svc := &fakeService{}
+	body := loadFixture(t, "opened.json")
+	signature := sign(body, testSecret)
+
+	tampered := bytes.Replace(body, []byte(`"alice"`), []byte(`"mallory"`), 1)
+	if rec := deliver(newTestRouter(t, svc), tampered, signature); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+	if len(svc.calls) != 0 {
+		t.Errorf("service was called: %v", svc.calls)
+	}
+}
+
+func TestOversizedPayloadRejected(t *testing.T) {
+	svc := &fakeService{}
+	body := bytes.Repeat([]byte(" "), maxPayloadSize+1)
+
+	rec := deliver(newTestRouter(t, svc), body, sign(body, testSecret))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status = %d, want 413", rec.Code)
+	}
+	if len(svc.calls) != 0 {
+		t.Errorf("service was called: %v", svc.calls)
+	}
+}
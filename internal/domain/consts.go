@@ -1,6 +1,55 @@
 package domain
 
 const (
+	PRStatusDraft  PullRequestStatus = "DRAFT"
 	PRStatusOpen   PullRequestStatus = "OPEN"
+	PRStatusClosed PullRequestStatus = "CLOSED"
 	PRStatusMerged PullRequestStatus = "MERGED"
 )
+
+const (
+	ReviewStatePending          ReviewState = "PENDING"
+	ReviewStateApproved         ReviewState = "APPROVED"
+	ReviewStateChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCommented        ReviewState = "COMMENTED"
+)
+
+const (
+	PRHistoryMerged         PRHistoryEvent = "MERGED"
+	PRHistoryForceMerged    PRHistoryEvent = "FORCE_MERGED"
+	PRHistoryExternalMerged PRHistoryEvent = "EXTERNAL_MERGED"
+	PRHistoryReady          PRHistoryEvent = "READY_FOR_REVIEW"
+	PRHistoryClosed         PRHistoryEvent = "CLOSED"
+	PRHistoryReopened       PRHistoryEvent = "REOPENED"
+)
+
+const (
+	AssignmentAssigned   AssignmentEventType = "ASSIGNED"
+	AssignmentUnassigned AssignmentEventType = "UNASSIGNED"
+	AssignmentReassigned AssignmentEventType = "REASSIGNED"
+)
+
+// причины изменения состава ревьюверов; для переходов статуса используется PRHistoryEvent
+const (
+	AssignmentReasonCreated         = "PR_CREATED"
+	AssignmentReasonManual          = "MANUAL_REASSIGN"
+	AssignmentReasonTeamDeactivated = "TEAM_DEACTIVATED"
+	AssignmentReasonUnavailable     = "USER_UNAVAILABLE"
+	AssignmentReasonSLAEscalated    = "SLA_ESCALATED"
+)
+
+const (
+	OutboxPRCreated          OutboxEventType = "pull_request.created"
+	OutboxPRReassigned       OutboxEventType = "pull_request.reassigned"
+	OutboxPRReviewersChanged OutboxEventType = "pull_request.reviewers_changed"
+	OutboxPRStatusChanged    OutboxEventType = "pull_request.status_changed"
+	OutboxPRMerged           OutboxEventType = "pull_request.merged"
+	OutboxPRReviewReminder   OutboxEventType = "pull_request.review_reminder"
+	OutboxTeamAssignmentSkew OutboxEventType = "team.assignment_skew"
+)
+
+const (
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySuccess WebhookDeliveryStatus = "SUCCESS"
+	WebhookDeliveryFailed  WebhookDeliveryStatus = "FAILED"
+)
@@ -9,9 +9,37 @@ var (
 	ErrNotAssigned = errors.New("NOT_ASSIGNED: reviewer is not assigned to this PR")
 	ErrNoCandidate = errors.New("NO_CANDIDATE: no active replacement candidate in team")
 
+	ErrCapacityExhausted = errors.New("CAPACITY_EXHAUSTED: all candidates reached their open review limit")
+	ErrInvalidCapacity   = errors.New("INVALID_CAPACITY: review limit must be positive")
+
+	ErrInvalidCodeOwners = errors.New("INVALID_CODEOWNERS")
+
+	ErrInvalidTag     = errors.New("INVALID_TAG: tag must be non-empty and at most 64 characters")
+	ErrTagsNotCovered = errors.New("TAGS_NOT_COVERED: no available reviewers with required tags")
+
+	ErrInvalidTransition  = errors.New("INVALID_TRANSITION: PR status transition is not allowed")
+	ErrNotApproved        = errors.New("NOT_APPROVED: PR does not satisfy merge approval rules")
+	ErrForbidden          = errors.New("FORBIDDEN: actor is not allowed to force merge")
+	ErrInvalidReviewState = errors.New("INVALID_REVIEW_STATE: state must be APPROVED, CHANGES_REQUESTED or COMMENTED")
+
 	ErrTeamExists   = errors.New("TEAM_EXISTS: team_name already exists")
 	ErrTeamNotFound = errors.New("NOT_FOUND: team not found")
 
+	ErrPolicyNotFound  = errors.New("NOT_FOUND: team policy not found")
+	ErrInvalidPolicy   = errors.New("INVALID_POLICY: min_reviewers must be in [0, max_reviewers] and default_capacity non-negative")
+	ErrInvalidFallback = errors.New("INVALID_FALLBACK: fallback chain must not contain the team itself or duplicates")
+
 	ErrUserNotFound  = errors.New("user not found")
+	ErrInvalidEmail  = errors.New("INVALID_EMAIL: email is not a valid address")
 	ErrNoAssignedPRs = errors.New("no PRs assigned to this user")
+	ErrInvalidCursor = errors.New("INVALID_CURSOR: cursor is malformed or was issued for another user, order or filter")
+
+	ErrInvalidPeriod          = errors.New("INVALID_PERIOD: ends_at must be after starts_at")
+	ErrInvalidStatsWindow     = errors.New("INVALID_PERIOD: to must be after from")
+	ErrInvalidStatusFilter    = errors.New("INVALID_FILTER: status must be one of DRAFT, OPEN, CLOSED, MERGED")
+	ErrUnavailabilityNotFound = errors.New("NOT_FOUND: unavailability period not found")
+
+	ErrInvalidWebhook          = errors.New("INVALID_WEBHOOK")
+	ErrWebhookNotFound         = errors.New("NOT_FOUND: webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("NOT_FOUND: webhook delivery not found")
 )
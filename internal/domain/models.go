@@ -7,6 +7,21 @@ type User struct {
 	Name     string
 	IsActive bool
 	TeamName string
+	// MaxOpenReviews — личный лимит открытых ревью; nil означает лимит команды
+	MaxOpenReviews *int
+	// Tags — навыки пользователя (go, sql, frontend)
+	Tags []string
+	// Email — адрес для уведомлений; пустая строка — писем не слать
+	Email string
+}
+
+// Unavailability — период, когда пользователь не может ревьюить (отпуск, out-of-office)
+type Unavailability struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
 }
 
 type Team struct {
@@ -14,6 +29,15 @@ type Team struct {
 	Members []User
 }
 
+type TeamPolicy struct {
+	TeamName         string
+	MinReviewers     int
+	MaxReviewers     int
+	AssignOnlyActive bool
+	// DefaultCapacity — лимит открытых ревью для участников без личного лимита, 0 — без ограничения
+	DefaultCapacity int
+}
+
 type PullRequestStatus string
 
 type PullRequest struct {
@@ -21,9 +45,218 @@ type PullRequest struct {
 	PullRequestName   string
 	AuthorId          string
 	AssignedReviewers []string
+	Reviewers         []Reviewer
+	ChangedPaths      []string
+	RequiredTags      []string
 	Status            PullRequestStatus
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	ClosedAt          *time.Time
+}
+
+type ReviewState string
+
+// Reviewer — назначенный ревьювер, команда, из которой он был выбран, и его вердикт
+type Reviewer struct {
+	UserID         string
+	TeamName       string
+	State          ReviewState
+	StateUpdatedAt *time.Time
+	AssignedAt     time.Time
+	RespondedAt    *time.Time
+}
+
+type PRHistoryEvent string
+
+// PRHistoryEntry — запись в истории жизненного цикла PR
+type PRHistoryEntry struct {
+	PullRequestID string
+	Event         PRHistoryEvent
+	ActorID       string
+	Details       string
+	CreatedAt     time.Time
+}
+
+type AssignmentEventType string
+
+// AssignmentEvent — запись журнала назначений: кто, кого и на кого заменил и почему
+type AssignmentEvent struct {
+	ID            int64
+	PullRequestID string
+	Type          AssignmentEventType
+	ActorID       string
+	OldReviewerID string
+	NewReviewerID string
+	Reason        string
+	Strategy      string
+	CreatedAt     time.Time
+}
+
+// AssignmentAudit — контекст изменения ревьюверов, который репозиторий пишет в журнал назначений
+type AssignmentAudit struct {
+	ActorID  string
+	Reason   string
+	Strategy string
+}
+
+type OutboxEventType string
+
+// OutboxEvent — доменное событие, записанное в outbox вместе с изменением PR.
+// Payload — JSON-снимок PR после изменения; PublishedSinks — sink'и, уже принявшие событие.
+type OutboxEvent struct {
+	ID             int64
+	Type           OutboxEventType
+	AggregateID    string
+	Payload        []byte
+	Attempts       int
+	CreatedAt      time.Time
+	PublishedSinks []string
+}
+
+// WebhookSubscription — подписка внешней системы на события PR.
+// Пустой Events — все события; TeamName ограничивает PR авторами из этой команды.
+type WebhookSubscription struct {
+	ID        int64
+	URL       string
+	Events    []OutboxEventType
+	Secret    string
+	TeamName  *string
+	CreatedAt time.Time
+}
+
+type WebhookDeliveryStatus string
+
+// WebhookDelivery — попытка доставки события подписчику
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	EventType      OutboxEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	ResponseCode   *int
+	Error          string
+	Attempts       int
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// CodeOwnerRule — строка CODEOWNERS: шаблон пути и его владельцы (пользователи и команды)
+type CodeOwnerRule struct {
+	Pattern string
+	Users   []string
+	Teams   []string
+}
+
+// PullRequestCreateParams — входные данные для создания PR
+type PullRequestCreateParams struct {
+	ID           string
+	Name         string
+	AuthorID     string
+	Draft        bool
+	ChangedPaths []string
+	// RequiredTags — навыки, каждый из которых должен быть хотя бы у одного ревьювера
+	RequiredTags []string
+}
+
+// ReviewDigest — ожидающие ревью пользователя для ежедневной сводки
+type ReviewDigest struct {
+	User         User
+	PullRequests []*PullRequest
+}
+
+// MergeOptions — параметры merge; Force позволяет администратору обойти правила одобрения.
+// ActorID не аутентифицируется, поэтому Force не является границей безопасности.
+type MergeOptions struct {
+	Force   bool
+	ActorID string
+	Reason  string
+}
+
+// LatencySummary — медиана и 90-й перцентиль длительности по Count наблюдениям
+type LatencySummary struct {
+	Count  int
+	Median time.Duration
+	P90    time.Duration
+}
+
+// UserLatency — время до первого ответа пользователя как ревьювера
+// и время до merge его PR как автора
+type UserLatency struct {
+	UserID      string
+	TeamName    string
+	FirstReview LatencySummary
+	Merge       LatencySummary
+}
+
+type TeamLatency struct {
+	TeamName    string
+	FirstReview LatencySummary
+	Merge       LatencySummary
+}
+
+// LatencyStats — метрики за окно [From, To): ответы ревьюверов по responded_at, merge по merged_at
+type LatencyStats struct {
+	From   time.Time
+	To     time.Time
+	ByUser []UserLatency
+	ByTeam []TeamLatency
+}
+
+// MemberAssignments — сколько раз участник команды был назначен ревьювером за окно
+type MemberAssignments struct {
+	TeamName         string
+	UserID           string
+	AssignmentsCount int
+}
+
+// TeamFairness — равномерность назначений среди активных участников команды за окно [From, To).
+// MaxMinRatio не задан, если кому-то не досталось ни одного ревью. Skewed — Gini выше порога.
+type TeamFairness struct {
+	TeamName         string
+	From             time.Time
+	To               time.Time
+	MembersCount     int
+	AssignmentsCount int
+	Mean             float64
+	Gini             float64
+	MaxMinRatio      *float64
+	Threshold        float64
+	Skewed           bool
+	OverAssigned     []MemberAssignments
+	UnderAssigned    []MemberAssignments
+}
+
+type FairnessReport struct {
+	From  time.Time
+	To    time.Time
+	Teams []TeamFairness
+}
+
+// ReviewInboxQuery — выборка PR, где пользователь назначен ревьювером.
+// PendingOnly оставляет открытые PR без вердикта; After — позиция, после которой продолжить выдачу.
+type ReviewInboxQuery struct {
+	UserID      string
+	PendingOnly bool
+	Status      PullRequestStatus
+	Ascending   bool
+	Limit       int
+	After       *ReviewInboxCursor
+}
+
+// ReviewInboxCursor — последний отданный PR в порядке (created_at, pull_request_id)
+type ReviewInboxCursor struct {
+	CreatedAt     time.Time
+	PullRequestID string
+}
+
+// StatsFilter отбирает PR для /stats: окно [From, To) по created_at, статус и команда автора.
+// Пустые поля не ограничивают выборку.
+type StatsFilter struct {
+	From     *time.Time
+	To       *time.Time
+	TeamName string
+	Status   PullRequestStatus
 }
 
 type AssignmentCountByUser struct {
@@ -35,3 +268,34 @@ type AssignmentCountByPR struct {
 	PullRequestID  string
 	ReviewersCount int
 }
+
+// AssignmentCountByTeam — PR, созданные участниками команды, и назначения её участников ревьюверами
+type AssignmentCountByTeam struct {
+	TeamName          string
+	PullRequestsCount int
+	AssignmentsCount  int
+}
+
+// AssignmentCountByDay — PR и назначения по дню создания PR (UTC)
+type AssignmentCountByDay struct {
+	Day               time.Time
+	PullRequestsCount int
+	AssignmentsCount  int
+}
+
+type AssignmentStats struct {
+	ByUser []AssignmentCountByUser
+	ByPR   []AssignmentCountByPR
+	ByTeam []AssignmentCountByTeam
+	ByDay  []AssignmentCountByDay
+}
+
+// StaleReview — ревью в OPEN PR, которое ревьювер ещё не начал.
+// AssignedAt — время назначения ревьювера,
+// RemindedAt — последнее напоминание после этого назначения.
+type StaleReview struct {
+	PullRequestID string
+	ReviewerID    string
+	AssignedAt    time.Time
+	RemindedAt    *time.Time
+}
@@ -0,0 +1,184 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const (
+	DefaultAssignedTemplate   = "@{{.Reviewer}}, you were assigned to {{.PullRequestID}} '{{.PullRequestName}}' by {{.Actor}}"
+	DefaultUnassignedTemplate = "@{{.Reviewer}}, you were unassigned from {{.PullRequestID}} '{{.PullRequestName}}' by {{.Actor}}"
+	DefaultMergedTemplate     = "{{.PullRequestID}} '{{.PullRequestName}}' by {{.Author}} was merged by {{.Actor}}"
+	DefaultReminderTemplate   = "@{{.Reviewer}}, {{.PullRequestID}} '{{.PullRequestName}}' by {{.Author}} is still waiting for your review"
+)
+
+// Chat отправляет уведомления о назначениях во входящие вебхуки Slack/Mattermost.
+// Канал выбирается по команде автора PR. Подключается к outbox как Sink.
+// На событие уходит одно сообщение, поэтому отдельной дедупликации нет: релей помечает доставку
+// по каждому sink'у и не повторяет событие в чате из-за ошибок других sink'ов.
+type Chat struct {
+	log        *slog.Logger
+	users      UserStore
+	client     *http.Client
+	defaultURL string
+	teamURLs   map[string]string
+	username   string
+
+	assigned   *template.Template
+	unassigned *template.Template
+	merged     *template.Template
+	reminder   *template.Template
+}
+
+func NewChat(log *slog.Logger, users UserStore, cfg config.ChatNotifications) (*Chat, error) {
+	c := &Chat{
+		log:        log,
+		users:      users,
+		client:     &http.Client{Timeout: cfg.Timeout},
+		defaultURL: cfg.DefaultURL,
+		teamURLs:   cfg.TeamURLs,
+		username:   cfg.Username,
+	}
+
+	var err error
+	if c.assigned, err = parseTemplate("chat assigned", cfg.Templates.Assigned, DefaultAssignedTemplate); err != nil {
+		return nil, err
+	}
+	if c.unassigned, err = parseTemplate("chat unassigned", cfg.Templates.Unassigned, DefaultUnassignedTemplate); err != nil {
+		return nil, err
+	}
+	if c.merged, err = parseTemplate("chat merged", cfg.Templates.Merged, DefaultMergedTemplate); err != nil {
+		return nil, err
+	}
+	if c.reminder, err = parseTemplate("chat reminder", cfg.Templates.Reminder, DefaultReminderTemplate); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Chat) Name() string {
+	return "chat"
+}
+
+func (c *Chat) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	p, err := decodePayload(event)
+	if err != nil {
+		return err
+	}
+	// события команды (team.*) в чат не пишем
+	if p.PullRequestID == "" {
+		return nil
+	}
+
+	author, err := c.users.GetUserById(ctx, p.AuthorID)
+	if err != nil {
+		return fmt.Errorf("failed to get PR author %s: %w", p.AuthorID, err)
+	}
+	url := c.defaultURL
+	if teamURL, ok := c.teamURLs[author.TeamName]; ok {
+		url = teamURL
+	}
+	if url == "" {
+		return nil
+	}
+
+	data := MessageData{
+		PullRequestID:   p.PullRequestID,
+		PullRequestName: p.PullRequestName,
+		Author:          author.Name,
+		Actor:           displayName(ctx, c.log, c.users, p.ActorID),
+		Reason:          p.Reason,
+		Status:          p.Status,
+	}
+
+	lines, err := c.render(ctx, event.Type, p, data)
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return c.send(ctx, url, strings.Join(lines, "\n"))
+}
+
+// render строит строки сообщения: снятым ревьюверам, назначенным, о merge или напоминание.
+// При закрытии PR ревьюверы снимаются все сразу — об этом не пишем.
+func (c *Chat) render(ctx context.Context, eventType domain.OutboxEventType, p payload, data MessageData) ([]string, error) {
+	if eventType == domain.OutboxPRReviewReminder {
+		data.Reviewer = displayName(ctx, c.log, c.users, p.ReviewerID)
+		line, err := execute(c.reminder, data)
+		if err != nil {
+			return nil, err
+		}
+		return []string{line}, nil
+	}
+
+	lines := make([]string, 0, len(p.AddedReviewers)+len(p.RemovedReviewers)+1)
+
+	if p.Status != domain.PRStatusClosed {
+		for _, id := range p.RemovedReviewers {
+			data.Reviewer = displayName(ctx, c.log, c.users, id)
+			line, err := execute(c.unassigned, data)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, line)
+		}
+	}
+	for _, id := range p.AddedReviewers {
+		data.Reviewer = displayName(ctx, c.log, c.users, id)
+		line, err := execute(c.assigned, data)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	if eventType == domain.OutboxPRMerged {
+		data.Reviewer = ""
+		line, err := execute(c.merged, data)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// send отправляет сообщение в формате входящего вебхука Slack/Mattermost
+func (c *Chat) send(ctx context.Context, url, text string) error {
+	msg := struct {
+		Text     string `json:"text"`
+		Username string `json:"username,omitempty"`
+	}{Text: text, Username: c.username}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chat message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build chat request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send chat message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("chat webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/smtp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const (
+	DefaultEmailSubjectTemplate = "Review requested: {{.PullRequestID}} '{{.PullRequestName}}'"
+	DefaultEmailBodyTemplate    = "Hi {{.Reviewer}},\n\nyou were assigned to review {{.PullRequestID}} '{{.PullRequestName}}' by {{.Author}}.\nAssigned by: {{.Actor}}\n"
+
+	DefaultReminderSubjectTemplate = "Review reminder: {{.PullRequestID}} '{{.PullRequestName}}'"
+	DefaultReminderBodyTemplate    = "Hi {{.Reviewer}},\n\n{{.PullRequestID}} '{{.PullRequestName}}' by {{.Author}} is still waiting for your review.\n"
+
+	digestTemplate = `Hi {{.User.Name}},
+
+you have {{len .PullRequests}} pending review(s):
+{{range .PullRequests}}
+- {{.PullRequestId}} '{{.PullRequestName}}' by {{.AuthorId}}{{end}}
+`
+)
+
+// Email отправляет письма о назначении и напоминания ревьюверам с указанным email (как Sink outbox)
+// и ежедневную сводку ожидающих ревью. Письма уходят по одному на ревьювера; доставка
+// отмечается по (событие, получатель), и при повторе события уже получившим письмо не шлём.
+type Email struct {
+	log        *slog.Logger
+	users      UserStore
+	deliveries DeliveryStore
+	cfg        config.SMTPNotifications
+
+	subject *template.Template
+	body    *template.Template
+	digest  *template.Template
+
+	reminderSubject *template.Template
+	reminderBody    *template.Template
+}
+
+func NewEmail(log *slog.Logger, users UserStore, deliveries DeliveryStore, cfg config.SMTPNotifications) (*Email, error) {
+	if cfg.Host == "" || cfg.From == "" {
+		return nil, fmt.Errorf("smtp notifications require host and from")
+	}
+
+	e := &Email{
+		log:        log,
+		users:      users,
+		deliveries: deliveries,
+		cfg:        cfg,
+		digest:     template.Must(template.New("email digest").Parse(digestTemplate)),
+	}
+
+	var err error
+	if e.subject, err = parseTemplate("email subject", cfg.Templates.Subject, DefaultEmailSubjectTemplate); err != nil {
+		return nil, err
+	}
+	if e.body, err = parseTemplate("email body", cfg.Templates.Body, DefaultEmailBodyTemplate); err != nil {
+		return nil, err
+	}
+	if e.reminderSubject, err = parseTemplate("email reminder subject", cfg.Templates.ReminderSubject, DefaultReminderSubjectTemplate); err != nil {
+		return nil, err
+	}
+	if e.reminderBody, err = parseTemplate("email reminder body", cfg.Templates.ReminderBody, DefaultReminderBodyTemplate); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *Email) Name() string {
+	return "email"
+}
+
+func (e *Email) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	p, err := decodePayload(event)
+	if err != nil {
+		return err
+	}
+	recipients, subjectTmpl, bodyTmpl := p.AddedReviewers, e.subject, e.body
+	if event.Type == domain.OutboxPRReviewReminder {
+		recipients, subjectTmpl, bodyTmpl = []string{p.ReviewerID}, e.reminderSubject, e.reminderBody
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	sent, err := e.deliveries.SentTo(ctx, event.ID, e.Name())
+	if err != nil {
+		return err
+	}
+
+	data := MessageData{
+		PullRequestID:   p.PullRequestID,
+		PullRequestName: p.PullRequestName,
+		Author:          displayName(ctx, e.log, e.users, p.AuthorID),
+		Actor:           displayName(ctx, e.log, e.users, p.ActorID),
+		Reason:          p.Reason,
+		Status:          p.Status,
+	}
+
+	for _, id := range recipients {
+		if slices.Contains(sent, id) {
+			continue
+		}
+		reviewer, err := e.users.GetUserById(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get reviewer %s: %w", id, err)
+		}
+		if reviewer.Email == "" {
+			continue
+		}
+
+		data.Reviewer = reviewer.Name
+		subject, err := execute(subjectTmpl, data)
+		if err != nil {
+			return err
+		}
+		body, err := execute(bodyTmpl, data)
+		if err != nil {
+			return err
+		}
+		if err := e.send(ctx, reviewer.Email, subject, body); err != nil {
+			return err
+		}
+		if err := e.deliveries.MarkSentTo(ctx, event.ID, e.Name(), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SendDigest отправляет сводки; ошибка одного письма не мешает остальным
+func (e *Email) SendDigest(ctx context.Context, digests []domain.ReviewDigest) error {
+	failed := 0
+	for _, d := range digests {
+		body, err := execute(e.digest, d)
+		if err == nil {
+			subject := fmt.Sprintf("Pending reviews: %d", len(d.PullRequests))
+			err = e.send(ctx, d.User.Email, subject, body)
+		}
+		if err != nil {
+			failed++
+			e.log.Warn("notify: failed to send review digest", slog.String("user_id", d.User.ID), slog.Any("err", err))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d of %d review digests", failed, len(digests))
+	}
+	return nil
+}
+
+// send отправляет text/plain письмо. STARTTLS используется, если сервер его предлагает,
+// авторизация — только если задан username (локальным SMTP-ловушкам она не нужна).
+func (e *Email) send(ctx context.Context, to, subject, body string) error {
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+
+	dialer := net.Dialer{Timeout: e.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp %s: %w", addr, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(e.cfg.Timeout))
+
+	client, err := smtp.NewClient(conn, e.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: e.cfg.Host}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+	if e.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate on smtp: %w", err)
+		}
+	}
+
+	if err := client.Mail(e.cfg.From); err != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp RCPT TO %s failed: %w", to, err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err := w.Write(buildMessage(e.cfg.From, to, subject, body)); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", to, err)
+	}
+	return client.Quit()
+}
+
+func buildMessage(from, to, subject, body string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return buf.Bytes()
+}
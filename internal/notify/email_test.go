@@ -0,0 +1,274 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// smtpSink — локальная SMTP-ловушка: принимает письма без TLS и авторизации,
+// на RCPT из reject отвечает временной ошибкой
+type smtpSink struct {
+	ln net.Listener
+
+	mu     sync.Mutex
+	mails  []mail
+	reject map[string]bool
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &smtpSink{ln: ln, reject: map[string]bool{}}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpSink) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) setReject(addr string, reject bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reject[addr] = reject
+}
+
+func (s *smtpSink) received() []mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.mails)
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+
+	reply("220 localhost ESMTP test")
+	var current mail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			current = mail{from: strings.Trim(line[len("MAIL FROM:"):], "<>")}
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			addr := strings.Trim(line[len("RCPT TO:"):], "<>")
+			s.mu.Lock()
+			rejected := s.reject[addr]
+			s.mu.Unlock()
+			if rejected {
+				reply("451 try again later")
+				continue
+			}
+			current.to = append(current.to, addr)
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			current.data = data.String()
+			s.mu.Lock()
+			s.mails = append(s.mails, current)
+			s.mu.Unlock()
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+type fakeUsers map[string]*domain.User
+
+func (f fakeUsers) GetUserById(_ context.Context, userId string) (*domain.User, error) {
+	u, ok := f[userId]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	return u, nil
+}
+
+type fakeDeliveries struct {
+	sent map[string][]string
+}
+
+func (f *fakeDeliveries) key(eventId int64, sink string) string {
+	return fmt.Sprintf("%d/%s", eventId, sink)
+}
+
+func (f *fakeDeliveries) SentTo(_ context.Context, eventId int64, sink string) ([]string, error) {
+	return f.sent[f.key(eventId, sink)], nil
+}
+
+func (f *fakeDeliveries) MarkSentTo(_ context.Context, eventId int64, sink, recipient string) error {
+	f.sent[f.key(eventId, sink)] = append(f.sent[f.key(eventId, sink)], recipient)
+	return nil
+}
+
+func newTestEmail(t *testing.T, smtp *smtpSink) *Email {
+	t.Helper()
+
+	users := fakeUsers{
+		"u1": {ID: "u1", Name: "alice", Email: "alice@example.com"},
+		"u2": {ID: "u2", Name: "bob", Email: "bob@example.com"},
+		"u3": {ID: "u3", Name: "carol", Email: "carol@example.com"},
+		"u4": {ID: "u4", Name: "dave"},
+	}
+	e, err := NewEmail(slog.New(slog.NewTextHandler(io.Discard, nil)), users, &fakeDeliveries{sent: map[string][]string{}}, config.SMTPNotifications{
+		Host:    "127.0.0.1",
+		Port:    smtp.port(),
+		From:    "reviews@example.com",
+		Timeout: time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewEmail: %v", err)
+	}
+	return e
+}
+
+func assignedEvent(t *testing.T, reviewers ...string) domain.OutboxEvent {
+	t.Helper()
+	body, err := json.Marshal(payload{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		Status:          domain.PRStatusOpen,
+		AddedReviewers:  reviewers,
+		ActorID:         "u1",
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return domain.OutboxEvent{ID: 7, Type: domain.OutboxPRCreated, Payload: body}
+}
+
+func recipients(mails []mail) []string {
+	res := make([]string, 0, len(mails))
+	for _, m := range mails {
+		res = append(res, m.to...)
+	}
+	return res
+}
+
+func TestEmailPublishSendsToReviewers(t *testing.T) {
+	smtp := newSMTPSink(t)
+	e := newTestEmail(t, smtp)
+
+	// у u4 нет email — ему не пишем
+	if err := e.Publish(context.Background(), assignedEvent(t, "u2", "u4", "u3")); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	mails := smtp.received()
+	if got := recipients(mails); !slices.Equal(got, []string{"bob@example.com", "carol@example.com"}) {
+		t.Fatalf("recipients = %v", got)
+	}
+	m := mails[0]
+	if m.from != "reviews@example.com" {
+		t.Errorf("from = %q", m.from)
+	}
+	for _, want := range []string{"To: bob@example.com", "Subject: Review requested: pr-1 'Add search'", "Hi bob,", "by alice."} {
+		if !strings.Contains(m.data, want) {
+			t.Errorf("message has no %q:\n%s", want, m.data)
+		}
+	}
+}
+
+func TestEmailRetryDoesNotResendDelivered(t *testing.T) {
+	smtp := newSMTPSink(t)
+	e := newTestEmail(t, smtp)
+	event := assignedEvent(t, "u2", "u3")
+
+	smtp.setReject("carol@example.com", true)
+	if err := e.Publish(context.Background(), event); err == nil {
+		t.Fatal("expected error for rejected recipient")
+	}
+
+	smtp.setReject("carol@example.com", false)
+	if err := e.Publish(context.Background(), event); err != nil {
+		t.Fatalf("retry Publish: %v", err)
+	}
+
+	if got := recipients(smtp.received()); !slices.Equal(got, []string{"bob@example.com", "carol@example.com"}) {
+		t.Errorf("recipients = %v, want each reviewer once", got)
+	}
+}
+
+func TestEmailSendDigest(t *testing.T) {
+	smtp := newSMTPSink(t)
+	e := newTestEmail(t, smtp)
+
+	digests := []domain.ReviewDigest{{
+		User: domain.User{ID: "u2", Name: "bob", Email: "bob@example.com"},
+		PullRequests: []*domain.PullRequest{
+			{PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1"},
+			{PullRequestId: "pr-2", PullRequestName: "Fix login", AuthorId: "u3"},
+		},
+	}}
+	if err := e.SendDigest(context.Background(), digests); err != nil {
+		t.Fatalf("SendDigest: %v", err)
+	}
+
+	mails := smtp.received()
+	if len(mails) != 1 {
+		t.Fatalf("mails = %d, want 1", len(mails))
+	}
+	for _, want := range []string{"Subject: Pending reviews: 2", "you have 2 pending review(s)", "- pr-1 'Add search' by u1", "- pr-2 'Fix login' by u3"} {
+		if !strings.Contains(mails[0].data, want) {
+			t.Errorf("digest has no %q:\n%s", want, mails[0].data)
+		}
+	}
+}
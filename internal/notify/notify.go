@@ -0,0 +1,93 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"text/template"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// systemActor подставляется в шаблон, если изменение сделал сам сервис
+const systemActor = "reviewer-bot"
+
+type UserStore interface {
+	GetUserById(ctx context.Context, userId string) (*domain.User, error)
+}
+
+// DeliveryStore хранит, каким получателям sink уже отправил событие,
+// чтобы при повторе не писать им снова
+type DeliveryStore interface {
+	SentTo(ctx context.Context, eventId int64, sink string) ([]string, error)
+	MarkSentTo(ctx context.Context, eventId int64, sink, recipient string) error
+}
+
+// MessageData — данные для шаблонов сообщений; имена — username, не user_id
+type MessageData struct {
+	PullRequestID   string
+	PullRequestName string
+	Author          string
+	Actor           string
+	Reviewer        string
+	Reason          string
+	Status          domain.PullRequestStatus
+}
+
+// payload — поля события outbox о PR, нужные уведомлениям
+type payload struct {
+	PullRequestID    string                   `json:"pull_request_id"`
+	PullRequestName  string                   `json:"pull_request_name"`
+	AuthorID         string                   `json:"author_id"`
+	Status           domain.PullRequestStatus `json:"status"`
+	AddedReviewers   []string                 `json:"added_reviewers"`
+	RemovedReviewers []string                 `json:"removed_reviewers"`
+	ActorID          string                   `json:"actor_id"`
+	Reason           string                   `json:"reason"`
+	ReviewerID       string                   `json:"reviewer_id"`
+}
+
+func decodePayload(event domain.OutboxEvent) (payload, error) {
+	var p payload
+	if err := json.Unmarshal(event.Payload, &p); err != nil {
+		return payload{}, fmt.Errorf("failed to decode payload of event %d: %w", event.ID, err)
+	}
+	return p, nil
+}
+
+func parseTemplate(name, text, fallback string) (*template.Template, error) {
+	if text == "" {
+		text = fallback
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+func execute(tmpl *template.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+// displayName возвращает username пользователя; для пустого id — имя бота, для неизвестного — сам id
+func displayName(ctx context.Context, log *slog.Logger, users UserStore, userId string) string {
+	if userId == "" {
+		return systemActor
+	}
+	user, err := users.GetUserById(ctx, userId)
+	if err != nil {
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			log.Warn("notify: failed to get user", slog.String("user_id", userId), slog.Any("err", err))
+		}
+		return userId
+	}
+	return user.Name
+}
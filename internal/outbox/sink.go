@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// Sink — получатель событий outbox. Релей повторяет событие только в тех sink'ах, которые
+// его не приняли, но доставка всё равно at-least-once: при падении между отправкой и
+// отметкой событие придёт повторно. Name должен быть уникален — по нему хранится отметка.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// Envelope — формат события для внешних получателей
+type Envelope struct {
+	ID          int64           `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID string          `json:"aggregate_id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+func NewEnvelope(event domain.OutboxEvent) Envelope {
+	return Envelope{
+		ID:          event.ID,
+		Type:        string(event.Type),
+		AggregateID: event.AggregateID,
+		CreatedAt:   event.CreatedAt,
+		Payload:     json.RawMessage(event.Payload),
+	}
+}
+
+// WebhookSink отправляет событие POST-запросом; любой ответ кроме 2xx считается ошибкой
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	body, err := json.Marshal(NewEnvelope(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event %d: %w", event.ID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", strconv.FormatInt(event.ID, 10))
+	req.Header.Set("X-Event-Type", string(event.Type))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// FileSink дописывает события в файл по одному JSON на строку
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open outbox log file %s: %w", path, err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Name() string {
+	return "log_file"
+}
+
+func (s *FileSink) Publish(_ context.Context, event domain.OutboxEvent) error {
+	line, err := json.Marshal(NewEnvelope(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event %d: %w", event.ID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event %d: %w", event.ID, err)
+	}
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,64 @@
+package pg_codeowners
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/lib/pq"
+)
+
+type CodeOwnersRepo struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *CodeOwnersRepo {
+	return &CodeOwnersRepo{db: db}
+}
+
+// SetRules целиком заменяет набор правил, порядок правил сохраняется
+func (r *CodeOwnersRepo) SetRules(ctx context.Context, rules []domain.CodeOwnerRule) ([]domain.CodeOwnerRule, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction for codeowners update: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM codeowner_rules"); err != nil {
+		return nil, fmt.Errorf("failed to clear codeowner rules: %w", err)
+	}
+
+	for i, rule := range rules {
+		query := "INSERT INTO codeowner_rules (position, pattern, user_ids, team_names) VALUES ($1, $2, $3, $4)"
+		_, err = tx.ExecContext(ctx, query, i, rule.Pattern, pq.Array(append([]string{}, rule.Users...)), pq.Array(append([]string{}, rule.Teams...)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert codeowner rule %q: %w", rule.Pattern, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction for codeowners update: %w", err)
+	}
+
+	return r.ListRules(ctx)
+}
+
+func (r *CodeOwnersRepo) ListRules(ctx context.Context) ([]domain.CodeOwnerRule, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT pattern, user_ids, team_names FROM codeowner_rules ORDER BY position")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query codeowner rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]domain.CodeOwnerRule, 0)
+	for rows.Next() {
+		var rule domain.CodeOwnerRule
+		if err := rows.Scan(&rule.Pattern, pq.Array(&rule.Users), pq.Array(&rule.Teams)); err != nil {
+			return nil, fmt.Errorf("failed to scan codeowner rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
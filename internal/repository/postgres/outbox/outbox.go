@@ -0,0 +1,125 @@
+package pg_outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/lib/pq"
+)
+
+type OutboxRepo struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *OutboxRepo {
+	return &OutboxRepo{db: db}
+}
+
+// ClaimDue забирает до limit готовых к отправке событий и сдвигает их next_attempt_at на lease,
+// чтобы параллельные релеи не отправили их одновременно. Если релей упадёт, событие вернётся после lease.
+func (r *OutboxRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = 'PENDING' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_type, aggregate_id, payload, attempts, created_at,
+			ARRAY(SELECT sink FROM outbox_sink_deliveries d WHERE d.event_id = outbox_events.id)
+	`
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.OutboxEvent, 0)
+	for rows.Next() {
+		var e domain.OutboxEvent
+		if err := rows.Scan(&e.ID, &e.Type, &e.AggregateID, &e.Payload, &e.Attempts, &e.CreatedAt, pq.Array(&e.PublishedSinks)); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *OutboxRepo) MarkPublished(ctx context.Context, id int64) error {
+	query := "UPDATE outbox_events SET status = 'PUBLISHED', published_at = NOW(), last_error = '' WHERE id = $1"
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark outbox event %d published: %w", id, err)
+	}
+	return nil
+}
+
+// Release снимает lease с забранных событий, чтобы их сразу подхватил следующий релей
+func (r *OutboxRepo) Release(ctx context.Context, ids []int64) error {
+	query := "UPDATE outbox_events SET next_attempt_at = NOW() WHERE id = ANY($1) AND status = 'PENDING'"
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return fmt.Errorf("failed to release outbox events: %w", err)
+	}
+	return nil
+}
+
+// MarkSinkPublished запоминает, что sink принял событие, чтобы при повторе не отправлять его туда снова
+func (r *OutboxRepo) MarkSinkPublished(ctx context.Context, id int64, sink string) error {
+	query := "INSERT INTO outbox_sink_deliveries (event_id, sink) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	if _, err := r.db.ExecContext(ctx, query, id, sink); err != nil {
+		return fmt.Errorf("failed to mark outbox event %d published to %s: %w", id, sink, err)
+	}
+	return nil
+}
+
+// SentTo возвращает получателей, которым sink уже доставил событие
+func (r *OutboxRepo) SentTo(ctx context.Context, id int64, sink string) ([]string, error) {
+	query := "SELECT recipient FROM outbox_recipient_deliveries WHERE event_id = $1 AND sink = $2"
+	rows, err := r.db.QueryContext(ctx, query, id, sink)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipients of outbox event %d: %w", id, err)
+	}
+	defer rows.Close()
+
+	recipients := make([]string, 0)
+	for rows.Next() {
+		var recipient string
+		if err := rows.Scan(&recipient); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox recipient: %w", err)
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, rows.Err()
+}
+
+func (r *OutboxRepo) MarkSentTo(ctx context.Context, id int64, sink, recipient string) error {
+	query := "INSERT INTO outbox_recipient_deliveries (event_id, sink, recipient) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+	if _, err := r.db.ExecContext(ctx, query, id, sink, recipient); err != nil {
+		return fmt.Errorf("failed to mark outbox event %d sent to %s: %w", id, recipient, err)
+	}
+	return nil
+}
+
+// MarkFailed увеличивает счётчик попыток и откладывает событие до next; dead — больше не пытаться
+func (r *OutboxRepo) MarkFailed(ctx context.Context, id int64, next time.Time, dead bool, errText string) error {
+	status := "PENDING"
+	if dead {
+		status = "DEAD"
+	}
+
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, next_attempt_at = $2, status = $3, last_error = $4
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, next, status, errText); err != nil {
+		return fmt.Errorf("failed to mark outbox event %d failed: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package pg_pr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// ListReviewInbox возвращает PR, где пользователь назначен ревьювером, в порядке created_at
+// (при равенстве — pull_request_id), не больше q.Limit штук. Ревьюверы PR не загружаются.
+func (r *PRRepo) ListReviewInbox(ctx context.Context, q domain.ReviewInboxQuery) ([]*domain.PullRequest, error) {
+	conds := []string{"prr.reviewer_id = $1"}
+	args := []any{q.UserID}
+
+	if q.PendingOnly {
+		args = append(args, domain.PRStatusOpen, domain.ReviewStatePending)
+		conds = append(conds, fmt.Sprintf("p.status = $%d AND prr.state = $%d", len(args)-1, len(args)))
+	}
+	if q.Status != "" {
+		args = append(args, q.Status)
+		conds = append(conds, fmt.Sprintf("p.status = $%d", len(args)))
+	}
+
+	order, cmp := "DESC", "<"
+	if q.Ascending {
+		order, cmp = "ASC", ">"
+	}
+	if q.After != nil {
+		args = append(args, q.After.CreatedAt, q.After.PullRequestID)
+		conds = append(conds, fmt.Sprintf("(p.created_at, p.pull_request_id) %s ($%d, $%d)", cmp, len(args)-1, len(args)))
+	}
+
+	args = append(args, q.Limit)
+	query := fmt.Sprintf(`
+        SELECT p.pull_request_id, p.pull_request_name, p.author_id, p.status, p.created_at
+        FROM pull_request_reviewers prr
+        JOIN pull_requests p ON p.pull_request_id = prr.pull_request_id
+        WHERE %s
+        ORDER BY p.created_at %s, p.pull_request_id %s
+        LIMIT $%d
+    `, strings.Join(conds, " AND "), order, order, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing ListReviewInbox query: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]*domain.PullRequest, 0)
+	for rows.Next() {
+		pr := &domain.PullRequest{}
+		if err := rows.Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &pr.Status, &pr.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning review inbox row: %w", err)
+		}
+		prs = append(prs, pr)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error in ListReviewInbox: %w", rows.Err())
+	}
+
+	return prs, nil
+}
@@ -0,0 +1,99 @@
+package pg_pr
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// prEventPayload — JSON-снимок PR, который уходит в outbox
+type prEventPayload struct {
+	PullRequestID    string                   `json:"pull_request_id"`
+	PullRequestName  string                   `json:"pull_request_name"`
+	AuthorID         string                   `json:"author_id"`
+	Status           domain.PullRequestStatus `json:"status"`
+	PreviousStatus   domain.PullRequestStatus `json:"previous_status,omitempty"`
+	Reviewers        []string                 `json:"reviewers"`
+	AddedReviewers   []string                 `json:"added_reviewers"`
+	RemovedReviewers []string                 `json:"removed_reviewers"`
+	ActorID          string                   `json:"actor_id,omitempty"`
+	Reason           string                   `json:"reason,omitempty"`
+	Strategy         string                   `json:"strategy,omitempty"`
+	ReviewerID       string                   `json:"reviewer_id,omitempty"`
+}
+
+func newPREventPayload(pr *domain.PullRequest, prevStatus domain.PullRequestStatus, added, removed []string, audit domain.AssignmentAudit) prEventPayload {
+	return prEventPayload{
+		PullRequestID:    pr.PullRequestId,
+		PullRequestName:  pr.PullRequestName,
+		AuthorID:         pr.AuthorId,
+		Status:           pr.Status,
+		PreviousStatus:   prevStatus,
+		Reviewers:        append([]string{}, pr.AssignedReviewers...),
+		AddedReviewers:   append([]string{}, added...),
+		RemovedReviewers: append([]string{}, removed...),
+		ActorID:          audit.ActorID,
+		Reason:           audit.Reason,
+		Strategy:         audit.Strategy,
+	}
+}
+
+// insertOutboxEvent пишет событие в outbox в рамках транзакции изменения PR
+func insertOutboxEvent(ctx context.Context, tx *sql.Tx, eventType domain.OutboxEventType, payload prEventPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event for PR %s: %w", eventType, payload.PullRequestID, err)
+	}
+
+	query := "INSERT INTO outbox_events (event_type, aggregate_id, payload) VALUES ($1, $2, $3)"
+	if _, err = tx.ExecContext(ctx, query, eventType, payload.PullRequestID, string(data)); err != nil {
+		return fmt.Errorf("failed to insert %s outbox event for PR %s: %w", eventType, payload.PullRequestID, err)
+	}
+	return nil
+}
+
+// loadEventSnapshot читает PR и его текущих ревьюверов внутри транзакции
+func loadEventSnapshot(ctx context.Context, tx *sql.Tx, prId string) (*domain.PullRequest, error) {
+	pr := &domain.PullRequest{PullRequestId: prId}
+	query := "SELECT pull_request_name, author_id, status FROM pull_requests WHERE pull_request_id = $1"
+	err := tx.QueryRowContext(ctx, query, prId).Scan(&pr.PullRequestName, &pr.AuthorId, &pr.Status)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrPRNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load PR %s for outbox event: %w", prId, err)
+	}
+
+	query = "SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1 ORDER BY reviewer_id"
+	rows, err := tx.QueryContext(ctx, query, prId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reviewers of PR %s for outbox event: %w", prId, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer of PR %s: %w", prId, err)
+		}
+		pr.AssignedReviewers = append(pr.AssignedReviewers, id)
+	}
+	return pr, rows.Err()
+}
+
+// updateEventType выбирает тип события для UpdatePR; пустая строка — ничего не изменилось
+func updateEventType(prevStatus, status domain.PullRequestStatus, added, removed []string) domain.OutboxEventType {
+	switch {
+	case prevStatus != status && status == domain.PRStatusMerged:
+		return domain.OutboxPRMerged
+	case prevStatus != status:
+		return domain.OutboxPRStatusChanged
+	case len(added) > 0 || len(removed) > 0:
+		return domain.OutboxPRReviewersChanged
+	default:
+		return ""
+	}
+}
@@ -3,8 +3,8 @@ package pg_pr
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/3eLLenKa/test-avito/internal/domain"
@@ -28,7 +28,7 @@ func (r *PRRepo) scanPRsWithReviewers(ctx context.Context, rowsPRs *sql.Rows) ([
 
 	for rowsPRs.Next() {
 		pr := &domain.PullRequest{AssignedReviewers: make([]string, 0)}
-		var mergedAt sql.NullTime
+		var mergedAt, closedAt sql.NullTime
 
 		if err := rowsPRs.Scan(
 			&pr.PullRequestId,
@@ -37,12 +37,18 @@ func (r *PRRepo) scanPRsWithReviewers(ctx context.Context, rowsPRs *sql.Rows) ([
 			&pr.Status,
 			&pr.CreatedAt,
 			&mergedAt,
+			&closedAt,
+			pq.Array(&pr.ChangedPaths),
+			pq.Array(&pr.RequiredTags),
 		); err != nil {
 			return nil, fmt.Errorf("error scanning pull request row: %w", err)
 		}
 		if mergedAt.Valid {
 			pr.MergedAt = &mergedAt.Time
 		}
+		if closedAt.Valid {
+			pr.ClosedAt = &closedAt.Time
+		}
 
 		prsMap[pr.PullRequestId] = pr
 		prIDs = append(prIDs, pr.PullRequestId)
@@ -56,9 +62,11 @@ func (r *PRRepo) scanPRsWithReviewers(ctx context.Context, rowsPRs *sql.Rows) ([
 	}
 
 	queryReviewers := `
-        SELECT pull_request_id, reviewer_id
-        FROM pull_request_reviewers
-        WHERE pull_request_id = ANY($1)
+        SELECT prr.pull_request_id, prr.reviewer_id, u.team_name, prr.state, prr.state_updated_at,
+               prr.assigned_at, prr.responded_at
+        FROM pull_request_reviewers prr
+        JOIN users u ON u.user_id = prr.reviewer_id
+        WHERE prr.pull_request_id = ANY($1)
     `
 
 	rowsReviewers, err := r.db.QueryContext(ctx, queryReviewers, pq.Array(prIDs))
@@ -69,12 +77,14 @@ func (r *PRRepo) scanPRsWithReviewers(ctx context.Context, rowsPRs *sql.Rows) ([
 	defer rowsReviewers.Close()
 
 	for rowsReviewers.Next() {
-		var prID, reviewerID string
-		if err := rowsReviewers.Scan(&prID, &reviewerID); err != nil {
+		var prID string
+		reviewer, err := scanReviewer(rowsReviewers, &prID)
+		if err != nil {
 			return nil, fmt.Errorf("error scanning reviewer row: %w", err)
 		}
 		if pr, ok := prsMap[prID]; ok {
-			pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+			pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer.UserID)
+			pr.Reviewers = append(pr.Reviewers, reviewer)
 		}
 	}
 	if rowsReviewers.Err() != nil {
@@ -88,9 +98,28 @@ func (r *PRRepo) scanPRsWithReviewers(ctx context.Context, rowsPRs *sql.Rows) ([
 	return prs, nil
 }
 
+// scanReviewer читает строку ревьювера; prefix — колонки перед reviewer_id
+func scanReviewer(rows *sql.Rows, prefix ...any) (domain.Reviewer, error) {
+	var reviewer domain.Reviewer
+	var stateUpdatedAt, respondedAt sql.NullTime
+
+	dest := append(prefix, &reviewer.UserID, &reviewer.TeamName, &reviewer.State, &stateUpdatedAt, &reviewer.AssignedAt, &respondedAt)
+	if err := rows.Scan(dest...); err != nil {
+		return domain.Reviewer{}, err
+	}
+	if stateUpdatedAt.Valid {
+		reviewer.StateUpdatedAt = &stateUpdatedAt.Time
+	}
+	if respondedAt.Valid {
+		reviewer.RespondedAt = &respondedAt.Time
+	}
+
+	return reviewer, nil
+}
+
 func (r *PRRepo) toDomainPR(row *sql.Row, prID string) (*domain.PullRequest, error) {
 	pr := &domain.PullRequest{PullRequestId: prID}
-	var mergedAt sql.NullTime
+	var mergedAt, closedAt sql.NullTime
 
 	err := row.Scan(
 		&pr.PullRequestName,
@@ -98,6 +127,9 @@ func (r *PRRepo) toDomainPR(row *sql.Row, prID string) (*domain.PullRequest, err
 		&pr.Status,
 		&pr.CreatedAt,
 		&mergedAt,
+		&closedAt,
+		pq.Array(&pr.ChangedPaths),
+		pq.Array(&pr.RequiredTags),
 	)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrPRNotFound
@@ -108,8 +140,18 @@ func (r *PRRepo) toDomainPR(row *sql.Row, prID string) (*domain.PullRequest, err
 	if mergedAt.Valid {
 		pr.MergedAt = &mergedAt.Time
 	}
+	if closedAt.Valid {
+		pr.ClosedAt = &closedAt.Time
+	}
 
-	rows, err := r.db.Query("SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1", prID)
+	queryReviewers := `
+        SELECT prr.reviewer_id, u.team_name, prr.state, prr.state_updated_at,
+               prr.assigned_at, prr.responded_at
+        FROM pull_request_reviewers prr
+        JOIN users u ON u.user_id = prr.reviewer_id
+        WHERE prr.pull_request_id = $1
+    `
+	rows, err := r.db.Query(queryReviewers, prID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query reviewers for PR %s: %w", prID, err)
 	}
@@ -117,11 +159,12 @@ func (r *PRRepo) toDomainPR(row *sql.Row, prID string) (*domain.PullRequest, err
 
 	var reviewers []string
 	for rows.Next() {
-		var reviewerID string
-		if err := rows.Scan(&reviewerID); err != nil {
+		reviewer, err := scanReviewer(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan reviewer ID for PR %s: %w", prID, err)
 		}
-		reviewers = append(reviewers, reviewerID)
+		reviewers = append(reviewers, reviewer.UserID)
+		pr.Reviewers = append(pr.Reviewers, reviewer)
 	}
 	pr.AssignedReviewers = reviewers
 
@@ -132,7 +175,7 @@ func (r *PRRepo) toDomainPR(row *sql.Row, prID string) (*domain.PullRequest, err
 	return pr, nil
 }
 
-func (r *PRRepo) Create(ctx context.Context, prId, prName, authorId string, reviewers []string, createdAt time.Time) (*domain.PullRequest, error) {
+func (r *PRRepo) Create(ctx context.Context, pr *domain.PullRequest, audit domain.AssignmentAudit) (*domain.PullRequest, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction for PR create: %w", err)
@@ -140,70 +183,147 @@ func (r *PRRepo) Create(ctx context.Context, prId, prName, authorId string, revi
 	defer tx.Rollback()
 
 	query := `
-        INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at)
-        VALUES ($1, $2, $3, $4, $5)
+        INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, changed_paths, required_tags)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
     `
-	_, err = tx.ExecContext(ctx, query, prId, prName, authorId, domain.PRStatusOpen, createdAt)
+	_, err = tx.ExecContext(ctx, query,
+		pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, pr.CreatedAt,
+		pq.Array(append([]string{}, pr.ChangedPaths...)),
+		pq.Array(append([]string{}, pr.RequiredTags...)),
+	)
 	if err != nil {
 		return nil, domain.ErrPRExists
 	}
 
-	for _, reviewerID := range reviewers {
-		_, err = tx.ExecContext(ctx, "INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)", prId, reviewerID)
+	assignedAt := time.Now().In(time.UTC)
+	for _, reviewerID := range pr.AssignedReviewers {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, assigned_at) VALUES ($1, $2, $3)",
+			pr.PullRequestId, reviewerID, assignedAt,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to insert reviewer %s for PR %s: %w", reviewerID, prId, err)
+			return nil, fmt.Errorf("failed to insert reviewer %s for PR %s: %w", reviewerID, pr.PullRequestId, err)
 		}
 	}
 
+	if err = insertAssignmentEvents(ctx, tx, pr.PullRequestId, nil, pr.AssignedReviewers, audit); err != nil {
+		return nil, err
+	}
+
+	payload := newPREventPayload(pr, "", pr.AssignedReviewers, nil, audit)
+	if err = insertOutboxEvent(ctx, tx, domain.OutboxPRCreated, payload); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction for PR create: %w", err)
 	}
 
-	return &domain.PullRequest{
-		PullRequestId:     prId,
-		PullRequestName:   prName,
-		AuthorId:          authorId,
-		Status:            domain.PRStatusOpen,
-		AssignedReviewers: reviewers,
-		CreatedAt:         &createdAt,
-	}, nil
+	return r.GetPR(ctx, pr.PullRequestId)
 }
 
 func (r *PRRepo) GetPR(ctx context.Context, prId string) (*domain.PullRequest, error) {
 	query := `
-        SELECT pull_request_name, author_id, status, created_at, merged_at
+        SELECT pull_request_name, author_id, status, created_at, merged_at, closed_at, changed_paths, required_tags
         FROM pull_requests
         WHERE pull_request_id = $1
     `
 	return r.toDomainPR(r.db.QueryRowContext(ctx, query, prId), prId)
 }
 
-func (r *PRRepo) UpdatePR(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error) {
-	if pr.Status != domain.PRStatusMerged {
-		return nil, errors.New("updatePR is only for merge status in this implementation")
+// UpdatePR сохраняет статус и состав ревьюверов PR; записи history и журнал назначений
+// пишутся в той же транзакции
+func (r *PRRepo) UpdatePR(ctx context.Context, pr *domain.PullRequest, audit domain.AssignmentAudit, history ...domain.PRHistoryEntry) (*domain.PullRequest, error) {
+	if pr.Status == domain.PRStatusMerged && pr.MergedAt == nil {
+		pr.MergedAt = new(time.Time)
+		*pr.MergedAt = time.Now().In(time.UTC)
 	}
 
-	pr.MergedAt = new(time.Time)
-	*pr.MergedAt = time.Now().In(time.UTC)
+	switch {
+	case pr.Status == domain.PRStatusClosed && pr.ClosedAt == nil:
+		pr.ClosedAt = new(time.Time)
+		*pr.ClosedAt = time.Now().In(time.UTC)
+	case pr.Status != domain.PRStatusClosed:
+		pr.ClosedAt = nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction for PR update: %w", err)
+	}
+	defer tx.Rollback()
+
+	var prevStatus domain.PullRequestStatus
+	err = tx.QueryRowContext(ctx, "SELECT status FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE", pr.PullRequestId).Scan(&prevStatus)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrPRNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock PR %s: %w", pr.PullRequestId, err)
+	}
 
 	query := `
         UPDATE pull_requests
-        SET status = $1, merged_at = $2
-        WHERE pull_request_id = $3
+        SET status = $1, merged_at = $2, closed_at = $3
+        WHERE pull_request_id = $4
     `
-	res, err := r.db.ExecContext(ctx, query, pr.Status, pr.MergedAt, pr.PullRequestId)
+	_, err = tx.ExecContext(ctx, query, pr.Status, pr.MergedAt, pr.ClosedAt, pr.PullRequestId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute update PR %s query: %w", pr.PullRequestId, err)
 	}
 
-	if rows, _ := res.RowsAffected(); rows == 0 {
-		return nil, domain.ErrPRNotFound
+	before, err := lockReviewers(ctx, tx, pr.PullRequestId)
+	if err != nil {
+		return nil, err
 	}
 
-	return pr, nil
+	// синхронизируем список ревьюверов: удаляем снятых и добавляем новых
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM pull_request_reviewers WHERE pull_request_id = $1 AND NOT (reviewer_id = ANY($2))",
+		pr.PullRequestId, pq.Array(append([]string{}, pr.AssignedReviewers...)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete removed reviewers for PR %s: %w", pr.PullRequestId, err)
+	}
+
+	// у оставшихся ревьюверов assigned_at не меняется
+	assignedAt := time.Now().In(time.UTC)
+	for _, reviewerID := range pr.AssignedReviewers {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, assigned_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+			pr.PullRequestId, reviewerID, assignedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert reviewer %s for PR %s: %w", reviewerID, pr.PullRequestId, err)
+		}
+	}
+
+	if err = insertAssignmentEvents(ctx, tx, pr.PullRequestId, before, pr.AssignedReviewers, audit); err != nil {
+		return nil, err
+	}
+
+	added, removed := diffReviewers(before, pr.AssignedReviewers)
+	if eventType := updateEventType(prevStatus, pr.Status, added, removed); eventType != "" {
+		payload := newPREventPayload(pr, prevStatus, added, removed, audit)
+		if err = insertOutboxEvent(ctx, tx, eventType, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entry := range history {
+		if err = insertHistory(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction for PR update: %w", err)
+	}
+
+	return r.GetPR(ctx, pr.PullRequestId)
 }
 
-func (r *PRRepo) Reassign(ctx context.Context, prId, oldUserId, newUserId string) (*domain.PullRequest, error) {
+func (r *PRRepo) Reassign(ctx context.Context, prId, oldUserId, newUserId string, audit domain.AssignmentAudit) (*domain.PullRequest, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction for PR reassign: %w", err)
@@ -218,11 +338,28 @@ func (r *PRRepo) Reassign(ctx context.Context, prId, oldUserId, newUserId string
 		return nil, domain.ErrNotAssigned
 	}
 
-	_, err = tx.ExecContext(ctx, "INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)", prId, newUserId)
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, assigned_at) VALUES ($1, $2, $3)",
+		prId, newUserId, time.Now().In(time.UTC),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to insert new reviewer %s: %w", newUserId, err)
 	}
 
+	err = insertAssignmentEvents(ctx, tx, prId, []string{oldUserId}, []string{newUserId}, audit)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := loadEventSnapshot(ctx, tx, prId)
+	if err != nil {
+		return nil, err
+	}
+	payload := newPREventPayload(snapshot, "", []string{newUserId}, []string{oldUserId}, audit)
+	if err = insertOutboxEvent(ctx, tx, domain.OutboxPRReassigned, payload); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction for PR reassign: %w", err)
 	}
@@ -232,7 +369,7 @@ func (r *PRRepo) Reassign(ctx context.Context, prId, oldUserId, newUserId string
 
 func (r *PRRepo) ListPRs(ctx context.Context) ([]*domain.PullRequest, error) {
 	query := `
-        SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+        SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, closed_at, changed_paths, required_tags
         FROM pull_requests
     `
 	rows, err := r.db.QueryContext(ctx, query)
@@ -252,7 +389,10 @@ func (r *PRRepo) ListOpenPRsByReviewers(ctx context.Context, deactivatedUserIDs
             pr.author_id, 
             pr.status, 
             pr.created_at, 
-            pr.merged_at
+            pr.merged_at,
+            pr.closed_at,
+            pr.changed_paths,
+            pr.required_tags
         FROM pull_requests pr
         WHERE pr.status = $2
           AND EXISTS (
@@ -275,3 +415,168 @@ func (r *PRRepo) ListOpenPRsByReviewers(ctx context.Context, deactivatedUserIDs
 
 	return r.scanPRsWithReviewers(ctx, rows)
 }
+
+func (r *PRRepo) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	query := `
+        SELECT prr.reviewer_id, COUNT(*)
+        FROM pull_request_reviewers prr
+        JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+        WHERE pr.status = $2
+          AND prr.reviewer_id = ANY($1)
+        GROUP BY prr.reviewer_id
+    `
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(userIDs), domain.PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("error executing CountOpenReviews query: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("error scanning open review count row: %w", err)
+		}
+		counts[userID] = count
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error in CountOpenReviews: %w", rows.Err())
+	}
+
+	return counts, nil
+}
+
+func (r *PRRepo) SetReviewState(ctx context.Context, prId, reviewerId string, state domain.ReviewState, at time.Time) (*domain.PullRequest, error) {
+	query := `
+        UPDATE pull_request_reviewers
+        SET state = $1, state_updated_at = $2, responded_at = COALESCE(responded_at, $2)
+        WHERE pull_request_id = $3 AND reviewer_id = $4
+    `
+	res, err := r.db.ExecContext(ctx, query, state, at, prId, reviewerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set review state of %s for PR %s: %w", reviewerId, prId, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, domain.ErrNotAssigned
+	}
+
+	return r.GetPR(ctx, prId)
+}
+
+func insertHistory(ctx context.Context, tx *sql.Tx, entry domain.PRHistoryEntry) error {
+	query := `
+        INSERT INTO pull_request_history (pull_request_id, event, actor_id, details, created_at)
+        VALUES ($1, $2, NULLIF($3, ''), $4, $5)
+    `
+	_, err := tx.ExecContext(ctx, query, entry.PullRequestID, entry.Event, entry.ActorID, entry.Details, entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert %s history entry for PR %s: %w", entry.Event, entry.PullRequestID, err)
+	}
+	return nil
+}
+
+// lockReviewers возвращает текущих ревьюверов PR и блокирует их строки до конца транзакции
+func lockReviewers(ctx context.Context, tx *sql.Tx, prId string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT reviewer_id FROM pull_request_reviewers WHERE pull_request_id = $1 ORDER BY reviewer_id FOR UPDATE",
+		prId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock reviewers for PR %s: %w", prId, err)
+	}
+	defer rows.Close()
+
+	reviewers := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer for PR %s: %w", prId, err)
+		}
+		reviewers = append(reviewers, id)
+	}
+	return reviewers, rows.Err()
+}
+
+// insertAssignmentEvents пишет в журнал разницу между составами ревьюверов before и after.
+// Снятые и добавленные ревьюверы попарно записываются как REASSIGNED, остаток — как UNASSIGNED/ASSIGNED.
+func insertAssignmentEvents(ctx context.Context, tx *sql.Tx, prId string, before, after []string, audit domain.AssignmentAudit) error {
+	added, removed := diffReviewers(before, after)
+
+	query := `
+        INSERT INTO assignment_events (pull_request_id, event_type, actor_id, old_reviewer_id, new_reviewer_id, reason, strategy)
+        VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, $7)
+    `
+	for i := 0; i < max(len(removed), len(added)); i++ {
+		var oldID, newID string
+		eventType := domain.AssignmentReassigned
+		switch {
+		case i >= len(removed):
+			eventType, newID = domain.AssignmentAssigned, added[i]
+		case i >= len(added):
+			eventType, oldID = domain.AssignmentUnassigned, removed[i]
+		default:
+			oldID, newID = removed[i], added[i]
+		}
+
+		_, err := tx.ExecContext(ctx, query, prId, eventType, audit.ActorID, oldID, newID, audit.Reason, audit.Strategy)
+		if err != nil {
+			return fmt.Errorf("failed to insert %s assignment event for PR %s: %w", eventType, prId, err)
+		}
+	}
+	return nil
+}
+
+// diffReviewers возвращает добавленных и снятых ревьюверов
+func diffReviewers(before, after []string) ([]string, []string) {
+	added := make([]string, 0)
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			added = append(added, id)
+		}
+	}
+	removed := make([]string, 0)
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
+// ListAssignmentEvents возвращает журнал назначений PR в порядке записи
+func (r *PRRepo) ListAssignmentEvents(ctx context.Context, prId string) ([]domain.AssignmentEvent, error) {
+	query := assignmentEventsSelect + " WHERE pull_request_id = $1 ORDER BY id"
+	return r.queryAssignmentEvents(ctx, query, prId)
+}
+
+// ListAssignmentEventsByUser возвращает события, где пользователь был снят или назначен
+func (r *PRRepo) ListAssignmentEventsByUser(ctx context.Context, userId string) ([]domain.AssignmentEvent, error) {
+	query := assignmentEventsSelect + " WHERE old_reviewer_id = $1 OR new_reviewer_id = $1 ORDER BY id"
+	return r.queryAssignmentEvents(ctx, query, userId)
+}
+
+const assignmentEventsSelect = `
+        SELECT id, pull_request_id, event_type, COALESCE(actor_id, ''),
+               COALESCE(old_reviewer_id, ''), COALESCE(new_reviewer_id, ''), reason, strategy, created_at
+        FROM assignment_events`
+
+func (r *PRRepo) queryAssignmentEvents(ctx context.Context, query string, args ...any) ([]domain.AssignmentEvent, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignment events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.AssignmentEvent, 0)
+	for rows.Next() {
+		var e domain.AssignmentEvent
+		err := rows.Scan(&e.ID, &e.PullRequestID, &e.Type, &e.ActorID, &e.OldReviewerID, &e.NewReviewerID, &e.Reason, &e.Strategy, &e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan assignment event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
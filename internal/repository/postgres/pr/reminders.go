@@ -0,0 +1,80 @@
+package pg_pr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// ListStaleReviews возвращает PENDING-ревью открытых PR, назначенные не позже assignedBefore.
+// Напоминание, отправленное до текущего назначения, не учитывается.
+func (r *PRRepo) ListStaleReviews(ctx context.Context, assignedBefore time.Time) ([]domain.StaleReview, error) {
+	query := `
+        SELECT prr.pull_request_id, prr.reviewer_id, prr.assigned_at,
+               CASE WHEN rm.reminded_at >= prr.assigned_at THEN rm.reminded_at END
+        FROM pull_request_reviewers prr
+        JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+        LEFT JOIN review_reminders rm
+               ON rm.pull_request_id = prr.pull_request_id AND rm.reviewer_id = prr.reviewer_id
+        WHERE pr.status = $1
+          AND prr.state = $2
+          AND prr.assigned_at <= $3
+        ORDER BY prr.assigned_at, prr.pull_request_id, prr.reviewer_id
+    `
+	rows, err := r.db.QueryContext(ctx, query, domain.PRStatusOpen, domain.ReviewStatePending, assignedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("error executing ListStaleReviews query: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []domain.StaleReview
+	for rows.Next() {
+		var sr domain.StaleReview
+		if err := rows.Scan(&sr.PullRequestID, &sr.ReviewerID, &sr.AssignedAt, &sr.RemindedAt); err != nil {
+			return nil, fmt.Errorf("error scanning stale review row: %w", err)
+		}
+		reviews = append(reviews, sr)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error in ListStaleReviews: %w", rows.Err())
+	}
+
+	return reviews, nil
+}
+
+// RecordReminder запоминает напоминание ревьюверу и пишет событие pull_request.review_reminder в outbox
+func (r *PRRepo) RecordReminder(ctx context.Context, prId, reviewerId string, at time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction for review reminder: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+        INSERT INTO review_reminders (pull_request_id, reviewer_id, reminded_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (pull_request_id, reviewer_id)
+        DO UPDATE SET reminded_at = EXCLUDED.reminded_at,
+                      reminders_count = review_reminders.reminders_count + 1
+    `
+	if _, err = tx.ExecContext(ctx, query, prId, reviewerId, at); err != nil {
+		return fmt.Errorf("failed to record reminder of %s for PR %s: %w", reviewerId, prId, err)
+	}
+
+	snapshot, err := loadEventSnapshot(ctx, tx, prId)
+	if err != nil {
+		return err
+	}
+	payload := newPREventPayload(snapshot, "", nil, nil, domain.AssignmentAudit{})
+	payload.ReviewerID = reviewerId
+	if err = insertOutboxEvent(ctx, tx, domain.OutboxPRReviewReminder, payload); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction for review reminder: %w", err)
+	}
+	return nil
+}
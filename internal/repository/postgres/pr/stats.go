@@ -0,0 +1,316 @@
+package pg_pr
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/lib/pq"
+)
+
+// строки с user_id = NULL — итоги по команде (GROUPING SETS)
+const (
+	firstReviewLatencyQuery = `
+        SELECT u.team_name, u.user_id, COUNT(*),
+               percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM prr.responded_at - prr.assigned_at)),
+               percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM prr.responded_at - prr.assigned_at))
+        FROM pull_request_reviewers prr
+        JOIN users u ON u.user_id = prr.reviewer_id
+        WHERE prr.responded_at >= $1 AND prr.responded_at < $2
+        GROUP BY GROUPING SETS ((u.team_name, u.user_id), (u.team_name))
+    `
+	mergeLatencyQuery = `
+        SELECT u.team_name, u.user_id, COUNT(*),
+               percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)),
+               percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at))
+        FROM pull_requests pr
+        JOIN users u ON u.user_id = pr.author_id
+        WHERE pr.status = $3 AND pr.merged_at >= $1 AND pr.merged_at < $2
+        GROUP BY GROUPING SETS ((u.team_name, u.user_id), (u.team_name))
+    `
+)
+
+// LatencyStats считает медиану и p90 времени до первого ответа ревьювера (по ревьюверу и его команде)
+// и времени от создания до merge (по автору и его команде) за окно [from, to)
+func (r *PRRepo) LatencyStats(ctx context.Context, from, to time.Time) (*domain.LatencyStats, error) {
+	users := make(map[string]*domain.UserLatency)
+	teams := make(map[string]*domain.TeamLatency)
+
+	pick := func(team string, user sql.NullString) (*domain.LatencySummary, *domain.LatencySummary) {
+		if !user.Valid {
+			t, ok := teams[team]
+			if !ok {
+				t = &domain.TeamLatency{TeamName: team}
+				teams[team] = t
+			}
+			return &t.FirstReview, &t.Merge
+		}
+		u, ok := users[user.String]
+		if !ok {
+			u = &domain.UserLatency{UserID: user.String, TeamName: team}
+			users[user.String] = u
+		}
+		return &u.FirstReview, &u.Merge
+	}
+
+	err := r.queryLatency(ctx, firstReviewLatencyQuery, func(team string, user sql.NullString, s domain.LatencySummary) {
+		firstReview, _ := pick(team, user)
+		*firstReview = s
+	}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	err = r.queryLatency(ctx, mergeLatencyQuery, func(team string, user sql.NullString, s domain.LatencySummary) {
+		_, merge := pick(team, user)
+		*merge = s
+	}, from, to, domain.PRStatusMerged)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.LatencyStats{
+		From:   from,
+		To:     to,
+		ByUser: make([]domain.UserLatency, 0, len(users)),
+		ByTeam: make([]domain.TeamLatency, 0, len(teams)),
+	}
+	for _, u := range users {
+		stats.ByUser = append(stats.ByUser, *u)
+	}
+	for _, t := range teams {
+		stats.ByTeam = append(stats.ByTeam, *t)
+	}
+	sort.Slice(stats.ByUser, func(i, j int) bool { return stats.ByUser[i].UserID < stats.ByUser[j].UserID })
+	sort.Slice(stats.ByTeam, func(i, j int) bool { return stats.ByTeam[i].TeamName < stats.ByTeam[j].TeamName })
+
+	return stats, nil
+}
+
+func (r *PRRepo) queryLatency(ctx context.Context, query string, apply func(team string, user sql.NullString, s domain.LatencySummary), args ...any) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error executing latency stats query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			team        string
+			user        sql.NullString
+			count       int
+			median, p90 float64
+		)
+		if err := rows.Scan(&team, &user, &count, &median, &p90); err != nil {
+			return fmt.Errorf("error scanning latency stats row: %w", err)
+		}
+		apply(team, user, domain.LatencySummary{
+			Count:  count,
+			Median: seconds(median),
+			P90:    seconds(p90),
+		})
+	}
+	if rows.Err() != nil {
+		return fmt.Errorf("rows iteration error in latency stats: %w", rows.Err())
+	}
+	return nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Second)
+}
+
+// statsFilterCTE строит CTE filtered с PR, подходящими под фильтр /stats, и аргументы к нему
+func statsFilterCTE(f domain.StatsFilter) (string, []any) {
+	var conds []string
+	var args []any
+	add := func(cond string, v any) {
+		args = append(args, v)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.From != nil {
+		add("p.created_at >= $%d", *f.From)
+	}
+	if f.To != nil {
+		add("p.created_at < $%d", *f.To)
+	}
+	if f.Status != "" {
+		add("p.status = $%d", f.Status)
+	}
+	if f.TeamName != "" {
+		add("a.team_name = $%d", f.TeamName)
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	cte := `
+        WITH filtered AS (
+            SELECT p.pull_request_id, p.created_at, a.team_name
+            FROM pull_requests p
+            JOIN users a ON a.user_id = p.author_id
+            ` + where + `
+        )`
+	return cte, args
+}
+
+// AssignmentStats агрегирует назначения ревьюверов по PR, подходящим под фильтр
+func (r *PRRepo) AssignmentStats(ctx context.Context, filter domain.StatsFilter) (*domain.AssignmentStats, error) {
+	cte, args := statsFilterCTE(filter)
+	stats := &domain.AssignmentStats{
+		ByUser: make([]domain.AssignmentCountByUser, 0),
+		ByPR:   make([]domain.AssignmentCountByPR, 0),
+		ByTeam: make([]domain.AssignmentCountByTeam, 0),
+		ByDay:  make([]domain.AssignmentCountByDay, 0),
+	}
+
+	query := cte + `
+        SELECT prr.reviewer_id, COUNT(*)
+        FROM filtered f
+        JOIN pull_request_reviewers prr ON prr.pull_request_id = f.pull_request_id
+        GROUP BY prr.reviewer_id
+        ORDER BY prr.reviewer_id
+    `
+	err := r.queryStats(ctx, "by user", query, args, func(rows *sql.Rows) error {
+		var c domain.AssignmentCountByUser
+		if err := rows.Scan(&c.UserID, &c.AssignmentsCount); err != nil {
+			return err
+		}
+		stats.ByUser = append(stats.ByUser, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query = cte + `
+        SELECT f.pull_request_id, COUNT(prr.reviewer_id)
+        FROM filtered f
+        LEFT JOIN pull_request_reviewers prr ON prr.pull_request_id = f.pull_request_id
+        GROUP BY f.pull_request_id
+        ORDER BY f.pull_request_id
+    `
+	err = r.queryStats(ctx, "by PR", query, args, func(rows *sql.Rows) error {
+		var c domain.AssignmentCountByPR
+		if err := rows.Scan(&c.PullRequestID, &c.ReviewersCount); err != nil {
+			return err
+		}
+		stats.ByPR = append(stats.ByPR, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// PR считаются по команде автора, назначения — по команде ревьювера
+	query = cte + `,
+        authored AS (
+            SELECT team_name, COUNT(*) AS prs
+            FROM filtered
+            GROUP BY team_name
+        ),
+        assigned AS (
+            SELECT u.team_name, COUNT(*) AS assignments
+            FROM filtered f
+            JOIN pull_request_reviewers prr ON prr.pull_request_id = f.pull_request_id
+            JOIN users u ON u.user_id = prr.reviewer_id
+            GROUP BY u.team_name
+        )
+        SELECT COALESCE(au.team_name, asg.team_name) AS team_name,
+               COALESCE(au.prs, 0), COALESCE(asg.assignments, 0)
+        FROM authored au
+        FULL JOIN assigned asg ON asg.team_name = au.team_name
+        ORDER BY team_name
+    `
+	err = r.queryStats(ctx, "by team", query, args, func(rows *sql.Rows) error {
+		var c domain.AssignmentCountByTeam
+		if err := rows.Scan(&c.TeamName, &c.PullRequestsCount, &c.AssignmentsCount); err != nil {
+			return err
+		}
+		stats.ByTeam = append(stats.ByTeam, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query = cte + `
+        SELECT date_trunc('day', f.created_at AT TIME ZONE 'UTC') AS day,
+               COUNT(DISTINCT f.pull_request_id), COUNT(prr.reviewer_id)
+        FROM filtered f
+        LEFT JOIN pull_request_reviewers prr ON prr.pull_request_id = f.pull_request_id
+        GROUP BY day
+        ORDER BY day
+    `
+	err = r.queryStats(ctx, "by day", query, args, func(rows *sql.Rows) error {
+		var c domain.AssignmentCountByDay
+		if err := rows.Scan(&c.Day, &c.PullRequestsCount, &c.AssignmentsCount); err != nil {
+			return err
+		}
+		c.Day = time.Date(c.Day.Year(), c.Day.Month(), c.Day.Day(), 0, 0, 0, 0, time.UTC)
+		stats.ByDay = append(stats.ByDay, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *PRRepo) queryStats(ctx context.Context, name, query string, args []any, scan func(rows *sql.Rows) error) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error executing stats %s query: %w", name, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("error scanning stats %s row: %w", name, err)
+		}
+	}
+	if rows.Err() != nil {
+		return fmt.Errorf("rows iteration error in stats %s: %w", name, rows.Err())
+	}
+	return nil
+}
+
+// MemberAssignmentCounts считает назначения (ASSIGNED и REASSIGNED из журнала) каждого активного
+// участника команды за окно [from, to); участники без назначений возвращаются с нулём.
+// Пустой teamName — все команды.
+func (r *PRRepo) MemberAssignmentCounts(ctx context.Context, teamName string, from, to time.Time) ([]domain.MemberAssignments, error) {
+	query := `
+        SELECT u.team_name, u.user_id, COUNT(ae.id)
+        FROM users u
+        LEFT JOIN assignment_events ae
+               ON ae.new_reviewer_id = u.user_id
+              AND ae.event_type = ANY($1)
+              AND ae.created_at >= $2 AND ae.created_at < $3
+        WHERE u.is_active
+          AND ($4 = '' OR u.team_name = $4)
+        GROUP BY u.team_name, u.user_id
+        ORDER BY u.team_name, u.user_id
+    `
+	eventTypes := pq.Array([]string{string(domain.AssignmentAssigned), string(domain.AssignmentReassigned)})
+
+	var counts []domain.MemberAssignments
+	err := r.queryStats(ctx, "member assignments", query, []any{eventTypes, from, to, teamName}, func(rows *sql.Rows) error {
+		var c domain.MemberAssignments
+		if err := rows.Scan(&c.TeamName, &c.UserID, &c.AssignmentsCount); err != nil {
+			return err
+		}
+		counts = append(counts, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
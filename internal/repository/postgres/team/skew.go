@@ -0,0 +1,89 @@
+package pg_team
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// skewEventPayload — JSON события team.assignment_skew в outbox
+type skewEventPayload struct {
+	TeamName      string          `json:"team_name"`
+	From          time.Time       `json:"from"`
+	To            time.Time       `json:"to"`
+	Gini          float64         `json:"gini"`
+	Threshold     float64         `json:"threshold"`
+	MaxMinRatio   *float64        `json:"max_min_ratio"`
+	OverAssigned  []skewEventUser `json:"over_assigned"`
+	UnderAssigned []skewEventUser `json:"under_assigned"`
+}
+
+type skewEventUser struct {
+	UserID           string `json:"user_id"`
+	AssignmentsCount int    `json:"assignments_count"`
+}
+
+func toSkewEventUsers(members []domain.MemberAssignments) []skewEventUser {
+	users := make([]skewEventUser, 0, len(members))
+	for _, m := range members {
+		users = append(users, skewEventUser{UserID: m.UserID, AssignmentsCount: m.AssignmentsCount})
+	}
+	return users
+}
+
+// UpdateSkewState сохраняет последнюю оценку перекоса назначений в команде. Если команда только что
+// перешла порог, в той же транзакции пишется событие team.assignment_skew в outbox и возвращается true.
+func (r *TeamRepo) UpdateSkewState(ctx context.Context, report domain.TeamFairness, at time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction for skew state: %w", err)
+	}
+	defer tx.Rollback()
+
+	var prevSkewed bool
+	err = tx.QueryRowContext(ctx, "SELECT skewed FROM team_skew_alerts WHERE team_name = $1 FOR UPDATE", report.TeamName).Scan(&prevSkewed)
+	if err != nil && err != sql.ErrNoRows {
+		return false, fmt.Errorf("failed to lock skew state of team %s: %w", report.TeamName, err)
+	}
+
+	query := `
+        INSERT INTO team_skew_alerts (team_name, skewed, gini, updated_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (team_name)
+        DO UPDATE SET skewed = EXCLUDED.skewed, gini = EXCLUDED.gini, updated_at = EXCLUDED.updated_at
+    `
+	if _, err = tx.ExecContext(ctx, query, report.TeamName, report.Skewed, report.Gini, at); err != nil {
+		return false, fmt.Errorf("failed to save skew state of team %s: %w", report.TeamName, err)
+	}
+
+	crossed := report.Skewed && !prevSkewed
+	if crossed {
+		data, err := json.Marshal(skewEventPayload{
+			TeamName:      report.TeamName,
+			From:          report.From,
+			To:            report.To,
+			Gini:          report.Gini,
+			Threshold:     report.Threshold,
+			MaxMinRatio:   report.MaxMinRatio,
+			OverAssigned:  toSkewEventUsers(report.OverAssigned),
+			UnderAssigned: toSkewEventUsers(report.UnderAssigned),
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal skew event for team %s: %w", report.TeamName, err)
+		}
+
+		query = "INSERT INTO outbox_events (event_type, aggregate_id, payload) VALUES ($1, $2, $3)"
+		if _, err = tx.ExecContext(ctx, query, domain.OutboxTeamAssignmentSkew, report.TeamName, string(data)); err != nil {
+			return false, fmt.Errorf("failed to insert skew outbox event for team %s: %w", report.TeamName, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction for skew state: %w", err)
+	}
+	return crossed, nil
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/3eLLenKa/test-avito/internal/domain"
+	pg_user "github.com/3eLLenKa/test-avito/internal/repository/postgres/user"
+	"github.com/lib/pq"
 )
 
 var (
@@ -35,17 +37,24 @@ func (r *TeamRepo) Add(ctx context.Context, teamName string, members []domain.Us
 
 	for _, member := range members {
 		query := `
-			INSERT INTO users (user_id, username, team_name, is_active)
-			VALUES ($1, $2, $3, $4)
+			INSERT INTO users (user_id, username, team_name, is_active, email)
+			VALUES ($1, $2, $3, $4, NULLIF($5, ''))
 			ON CONFLICT (user_id) DO UPDATE SET
 				username = EXCLUDED.username,
 				team_name = EXCLUDED.team_name,
-				is_active = EXCLUDED.is_active
+				is_active = EXCLUDED.is_active,
+				email = COALESCE(EXCLUDED.email, users.email)
 		`
-		_, err = tx.ExecContext(ctx, query, member.ID, member.Name, teamName, member.IsActive)
+		_, err = tx.ExecContext(ctx, query, member.ID, member.Name, teamName, member.IsActive, member.Email)
 		if err != nil {
 			return nil, err
 		}
+
+		if member.Tags != nil {
+			if err = pg_user.ReplaceTags(ctx, tx, member.ID, member.Tags); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -65,7 +74,13 @@ func (r *TeamRepo) GetTeam(ctx context.Context, teamName string) (*domain.Team,
 		return nil, err
 	}
 
-	query := "SELECT user_id, username, is_active FROM users WHERE team_name = $1"
+	query := `
+		SELECT user_id, username, is_active,
+		       ARRAY(SELECT tag FROM user_tags t WHERE t.user_id = u.user_id ORDER BY tag),
+		       COALESCE(email, '')
+		FROM users u
+		WHERE team_name = $1
+	`
 	rows, err := r.db.QueryContext(ctx, query, teamName)
 	if err != nil {
 		return nil, err
@@ -77,7 +92,7 @@ func (r *TeamRepo) GetTeam(ctx context.Context, teamName string) (*domain.Team,
 		var member domain.User
 		member.TeamName = teamName
 
-		if err := rows.Scan(&member.ID, &member.Name, &member.IsActive); err != nil {
+		if err := rows.Scan(&member.ID, &member.Name, &member.IsActive, pq.Array(&member.Tags), &member.Email); err != nil {
 			return nil, err
 		}
 
@@ -89,3 +104,82 @@ func (r *TeamRepo) GetTeam(ctx context.Context, teamName string) (*domain.Team,
 		Members: members,
 	}, rows.Err()
 }
+
+func (r *TeamRepo) SetPolicy(ctx context.Context, policy domain.TeamPolicy) (*domain.TeamPolicy, error) {
+	query := `
+		INSERT INTO team_policies (team_name, min_reviewers, max_reviewers, assign_only_active, default_capacity)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (team_name) DO UPDATE SET
+			min_reviewers = EXCLUDED.min_reviewers,
+			max_reviewers = EXCLUDED.max_reviewers,
+			assign_only_active = EXCLUDED.assign_only_active,
+			default_capacity = EXCLUDED.default_capacity
+	`
+	_, err := r.db.ExecContext(ctx, query, policy.TeamName, policy.MinReviewers, policy.MaxReviewers, policy.AssignOnlyActive, policy.DefaultCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *TeamRepo) GetPolicy(ctx context.Context, teamName string) (*domain.TeamPolicy, error) {
+	policy := &domain.TeamPolicy{TeamName: teamName}
+	query := "SELECT min_reviewers, max_reviewers, assign_only_active, default_capacity FROM team_policies WHERE team_name = $1"
+
+	err := r.db.QueryRowContext(ctx, query, teamName).Scan(&policy.MinReviewers, &policy.MaxReviewers, &policy.AssignOnlyActive, &policy.DefaultCapacity)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrPolicyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (r *TeamRepo) SetFallbacks(ctx context.Context, teamName string, fallbacks []string) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM team_fallbacks WHERE team_name = $1", teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, fallback := range fallbacks {
+		query := "INSERT INTO team_fallbacks (team_name, position, fallback_team) VALUES ($1, $2, $3)"
+		if _, err = tx.ExecContext(ctx, query, teamName, i, fallback); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetFallbacks(ctx, teamName)
+}
+
+func (r *TeamRepo) GetFallbacks(ctx context.Context, teamName string) ([]string, error) {
+	query := "SELECT fallback_team FROM team_fallbacks WHERE team_name = $1 ORDER BY position"
+	rows, err := r.db.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fallbacks := make([]string, 0)
+	for rows.Next() {
+		var fallback string
+		if err := rows.Scan(&fallback); err != nil {
+			return nil, err
+		}
+		fallbacks = append(fallbacks, fallback)
+	}
+
+	return fallbacks, rows.Err()
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/lib/pq"
 )
 
 type UserRepo struct {
@@ -18,12 +20,21 @@ func New(db *sql.DB) *UserRepo {
 
 func (r *UserRepo) GetUserById(ctx context.Context, userId string) (*domain.User, error) {
 	user := &domain.User{}
-	query := "SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1"
+	query := `
+        SELECT user_id, username, team_name, is_active, max_open_reviews,
+               ARRAY(SELECT tag FROM user_tags t WHERE t.user_id = u.user_id ORDER BY tag),
+               COALESCE(email, '')
+        FROM users u
+        WHERE user_id = $1
+    `
 	err := r.db.QueryRowContext(ctx, query, userId).Scan(
 		&user.ID,
 		&user.Name,
 		&user.TeamName,
 		&user.IsActive,
+		&user.MaxOpenReviews,
+		pq.Array(&user.Tags),
+		&user.Email,
 	)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrUserNotFound
@@ -32,10 +43,26 @@ func (r *UserRepo) GetUserById(ctx context.Context, userId string) (*domain.User
 }
 
 func (r *UserRepo) SetUserActive(ctx context.Context, userId string, isActive bool) (*domain.User, error) {
-	query := "UPDATE users SET is_active = $1 WHERE user_id = $2 RETURNING username, team_name"
+	query := "UPDATE users SET is_active = $1 WHERE user_id = $2 RETURNING username, team_name, max_open_reviews, COALESCE(email, '')"
 	user := &domain.User{ID: userId, IsActive: isActive}
 
-	err := r.db.QueryRowContext(ctx, query, isActive, userId).Scan(&user.Name, &user.TeamName)
+	err := r.db.QueryRowContext(ctx, query, isActive, userId).Scan(&user.Name, &user.TeamName, &user.MaxOpenReviews, &user.Email)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// SetMaxOpenReviews задаёт личный лимит открытых ревью; nil сбрасывает его на лимит команды
+func (r *UserRepo) SetMaxOpenReviews(ctx context.Context, userId string, limit *int) (*domain.User, error) {
+	query := "UPDATE users SET max_open_reviews = $1 WHERE user_id = $2 RETURNING username, team_name, is_active, COALESCE(email, '')"
+	user := &domain.User{ID: userId, MaxOpenReviews: limit}
+
+	err := r.db.QueryRowContext(ctx, query, limit, userId).Scan(&user.Name, &user.TeamName, &user.IsActive, &user.Email)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrUserNotFound
 	}
@@ -46,6 +73,106 @@ func (r *UserRepo) SetUserActive(ctx context.Context, userId string, isActive bo
 	return user, nil
 }
 
+// SetEmail задаёт адрес для уведомлений; пустая строка удаляет его
+func (r *UserRepo) SetEmail(ctx context.Context, userId string, email string) (*domain.User, error) {
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET email = NULLIF($1, '') WHERE user_id = $2", email, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update email of user %s: %w", userId, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return r.GetUserById(ctx, userId)
+}
+
+// ListUsersWithEmail возвращает активных пользователей, у которых указан email
+func (r *UserRepo) ListUsersWithEmail(ctx context.Context) ([]domain.User, error) {
+	query := `
+        SELECT user_id, username, team_name, email
+        FROM users
+        WHERE email IS NOT NULL AND is_active = TRUE
+        ORDER BY user_id
+    `
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error executing ListUsersWithEmail query: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		user := domain.User{IsActive: true}
+		if err := rows.Scan(&user.ID, &user.Name, &user.TeamName, &user.Email); err != nil {
+			return nil, fmt.Errorf("error scanning user row: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// SetTags целиком заменяет навыки пользователя
+func (r *UserRepo) SetTags(ctx context.Context, userId string, tags []string) (*domain.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction for tags update: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1)", userId).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err = ReplaceTags(ctx, tx, userId, tags); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction for tags update: %w", err)
+	}
+
+	return r.GetUserById(ctx, userId)
+}
+
+// ReplaceTags заменяет навыки пользователя в рамках внешней транзакции
+func ReplaceTags(ctx context.Context, tx *sql.Tx, userId string, tags []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_tags WHERE user_id = $1", userId); err != nil {
+		return fmt.Errorf("failed to clear tags of user %s: %w", userId, err)
+	}
+
+	query := "INSERT INTO user_tags (user_id, tag) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING"
+	if _, err := tx.ExecContext(ctx, query, userId, pq.Array(append([]string{}, tags...))); err != nil {
+		return fmt.Errorf("failed to insert tags of user %s: %w", userId, err)
+	}
+	return nil
+}
+
+// ListTags возвращает навыки указанных пользователей; пользователи без навыков в карту не попадают
+func (r *UserRepo) ListTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT user_id, tag FROM user_tags WHERE user_id = ANY($1) ORDER BY user_id, tag", pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("error executing ListTags query: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string)
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, fmt.Errorf("error scanning user tag row: %w", err)
+		}
+		tags[userID] = append(tags[userID], tag)
+	}
+
+	return tags, rows.Err()
+}
+
 func (r *UserRepo) DeactivateByTeam(ctx context.Context, teamName string) ([]string, error) {
 	query := `
         UPDATE users 
@@ -76,15 +203,32 @@ func (r *UserRepo) DeactivateByTeam(ctx context.Context, teamName string) ([]str
 	return deactivatedIDs, nil
 }
 
-func (r *UserRepo) ListActiveMembersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]domain.User, error) {
+// ListActiveMembersByTeam возвращает активных участников команды, доступных в момент at
+func (r *UserRepo) ListActiveMembersByTeam(ctx context.Context, teamName string, excludeUserID string, at time.Time) ([]domain.User, error) {
+	return r.listAvailableMembers(ctx, teamName, excludeUserID, true, at)
+}
+
+// ListAvailableMembersByTeam возвращает всех участников команды (включая неактивных), доступных в момент at
+func (r *UserRepo) ListAvailableMembersByTeam(ctx context.Context, teamName string, excludeUserID string, at time.Time) ([]domain.User, error) {
+	return r.listAvailableMembers(ctx, teamName, excludeUserID, false, at)
+}
+
+func (r *UserRepo) listAvailableMembers(ctx context.Context, teamName, excludeUserID string, onlyActive bool, at time.Time) ([]domain.User, error) {
 	query := `
-        SELECT user_id, username, team_name, is_active
-        FROM users
-        WHERE team_name = $1 
-          AND is_active = TRUE 
-          AND user_id != $2;
+        SELECT u.user_id, u.username, u.team_name, u.is_active, u.max_open_reviews
+        FROM users u
+        WHERE u.team_name = $1 
+          AND (u.is_active = TRUE OR NOT $3)
+          AND u.user_id != $2
+          AND NOT EXISTS (
+              SELECT 1
+              FROM user_unavailability ua
+              WHERE ua.user_id = u.user_id
+                AND ua.starts_at <= $4
+                AND ua.ends_at > $4
+          );
     `
-	rows, err := r.db.QueryContext(ctx, query, teamName, excludeUserID)
+	rows, err := r.db.QueryContext(ctx, query, teamName, excludeUserID, onlyActive, at)
 	if err != nil {
 		return nil, fmt.Errorf("error executing ListActiveMembersByTeam query for team %s: %w", teamName, err)
 	}
@@ -93,7 +237,7 @@ func (r *UserRepo) ListActiveMembersByTeam(ctx context.Context, teamName string,
 	members := make([]domain.User, 0)
 	for rows.Next() {
 		var u domain.User
-		if err := rows.Scan(&u.ID, &u.Name, &u.TeamName, &u.IsActive); err != nil {
+		if err := rows.Scan(&u.ID, &u.Name, &u.TeamName, &u.IsActive, &u.MaxOpenReviews); err != nil {
 			return nil, fmt.Errorf("error scanning active user row for team %s: %w", teamName, err)
 		}
 		members = append(members, u)
@@ -105,3 +249,75 @@ func (r *UserRepo) ListActiveMembersByTeam(ctx context.Context, teamName string,
 
 	return members, nil
 }
+
+func (r *UserRepo) AddUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error) {
+	query := `
+        INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id
+    `
+	err := r.db.QueryRowContext(ctx, query, period.UserID, period.StartsAt, period.EndsAt, period.Reason).Scan(&period.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error inserting unavailability for user %s: %w", period.UserID, err)
+	}
+
+	return &period, nil
+}
+
+func (r *UserRepo) UpdateUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error) {
+	query := `
+        UPDATE user_unavailability
+        SET starts_at = $1, ends_at = $2, reason = $3
+        WHERE id = $4
+        RETURNING user_id
+    `
+	err := r.db.QueryRowContext(ctx, query, period.StartsAt, period.EndsAt, period.Reason, period.ID).Scan(&period.UserID)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrUnavailabilityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error updating unavailability %d: %w", period.ID, err)
+	}
+
+	return &period, nil
+}
+
+func (r *UserRepo) DeleteUnavailability(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM user_unavailability WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("error deleting unavailability %d: %w", id, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return domain.ErrUnavailabilityNotFound
+	}
+	return nil
+}
+
+func (r *UserRepo) ListUnavailability(ctx context.Context, userId string) ([]domain.Unavailability, error) {
+	query := `
+        SELECT id, user_id, starts_at, ends_at, reason
+        FROM user_unavailability
+        WHERE user_id = $1
+        ORDER BY starts_at
+    `
+	rows, err := r.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error executing ListUnavailability query for user %s: %w", userId, err)
+	}
+	defer rows.Close()
+
+	periods := make([]domain.Unavailability, 0)
+	for rows.Next() {
+		var p domain.Unavailability
+		if err := rows.Scan(&p.ID, &p.UserID, &p.StartsAt, &p.EndsAt, &p.Reason); err != nil {
+			return nil, fmt.Errorf("error scanning unavailability row for user %s: %w", userId, err)
+		}
+		periods = append(periods, p)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error in ListUnavailability: %w", rows.Err())
+	}
+
+	return periods, nil
+}
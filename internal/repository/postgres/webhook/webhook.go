@@ -0,0 +1,199 @@
+package pg_webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/lib/pq"
+)
+
+type WebhookRepo struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *WebhookRepo {
+	return &WebhookRepo{db: db}
+}
+
+const subscriptionSelect = "SELECT id, url, events, secret, team_name, created_at FROM webhook_subscriptions"
+
+const deliverySelect = `
+	SELECT id, subscription_id, event_id, event_type, payload, status, response_code, last_error, attempts, created_at, delivered_at
+	FROM webhook_deliveries
+`
+
+func (r *WebhookRepo) CreateSubscription(ctx context.Context, sub domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	query := `
+		INSERT INTO webhook_subscriptions (url, events, secret, team_name)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query, sub.URL, pq.Array(eventsToStrings(sub.Events)), sub.Secret, sub.TeamName).Scan(&sub.ID, &sub.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert webhook subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (r *WebhookRepo) GetSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, subscriptionSelect+" WHERE id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscription %d: %w", id, err)
+	}
+	subs, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return &subs[0], nil
+}
+
+func (r *WebhookRepo) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, subscriptionSelect+" ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions: %w", err)
+	}
+	return scanSubscriptions(rows)
+}
+
+// MatchSubscriptions возвращает подписки на eventType, под область которых попадает автор PR
+// или, для событий команды, сама команда teamName
+func (r *WebhookRepo) MatchSubscriptions(ctx context.Context, eventType domain.OutboxEventType, authorId, teamName string) ([]domain.WebhookSubscription, error) {
+	query := subscriptionSelect + `
+		WHERE (cardinality(events) = 0 OR $1 = ANY(events))
+		  AND (team_name IS NULL OR team_name = COALESCE(NULLIF($3, ''), (SELECT team_name FROM users WHERE user_id = $2)))
+		ORDER BY id
+	`
+	rows, err := r.db.QueryContext(ctx, query, eventType, authorId, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions for %s: %w", eventType, err)
+	}
+	return scanSubscriptions(rows)
+}
+
+func (r *WebhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription %d: %w", id, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// EnsureDelivery создаёт запись о доставке события подписчику или возвращает существующую,
+// чтобы повтор события из outbox не задвоил доставку
+func (r *WebhookRepo) EnsureDelivery(ctx context.Context, d domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, d.SubscriptionID, d.EventID, d.EventType, string(d.Payload)); err != nil {
+		return nil, fmt.Errorf("failed to insert delivery of event %d to subscription %d: %w", d.EventID, d.SubscriptionID, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, deliverySelect+" WHERE subscription_id = $1 AND event_id = $2", d.SubscriptionID, d.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query delivery of event %d to subscription %d: %w", d.EventID, d.SubscriptionID, err)
+	}
+	deliveries, err := scanDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return &deliveries[0], nil
+}
+
+// FinishDelivery сохраняет результат очередной попытки доставки
+func (r *WebhookRepo) FinishDelivery(ctx context.Context, id int64, status domain.WebhookDeliveryStatus, responseCode *int, errText string) (*domain.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, response_code = $3, last_error = $4, attempts = attempts + 1,
+		    delivered_at = CASE WHEN $2 = 'SUCCESS' THEN NOW() ELSE delivered_at END
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(ctx, query, id, status, responseCode, errText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook delivery %d: %w", id, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return r.GetDelivery(ctx, id)
+}
+
+func (r *WebhookRepo) GetDelivery(ctx context.Context, id int64) (*domain.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, deliverySelect+" WHERE id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook delivery %d: %w", id, err)
+	}
+	deliveries, err := scanDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return &deliveries[0], nil
+}
+
+// ListDeliveries возвращает последние limit доставок подписки, новые первыми
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]domain.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, deliverySelect+" WHERE subscription_id = $1 ORDER BY id DESC LIMIT $2", subscriptionId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deliveries of subscription %d: %w", subscriptionId, err)
+	}
+	return scanDeliveries(rows)
+}
+
+func scanSubscriptions(rows *sql.Rows) ([]domain.WebhookSubscription, error) {
+	defer rows.Close()
+
+	subs := make([]domain.WebhookSubscription, 0)
+	for rows.Next() {
+		var (
+			sub    domain.WebhookSubscription
+			events []string
+		)
+		if err := rows.Scan(&sub.ID, &sub.URL, pq.Array(&events), &sub.Secret, &sub.TeamName, &sub.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook subscription: %w", err)
+		}
+		for _, e := range events {
+			sub.Events = append(sub.Events, domain.OutboxEventType(e))
+		}
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+func scanDeliveries(rows *sql.Rows) ([]domain.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var d domain.WebhookDelivery
+		err := rows.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Payload, &d.Status,
+			&d.ResponseCode, &d.Error, &d.Attempts, &d.CreatedAt, &d.DeliveredAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+func eventsToStrings(events []domain.OutboxEventType) []string {
+	out := make([]string, 0, len(events))
+	for _, e := range events {
+		out = append(out, string(e))
+	}
+	return out
+}
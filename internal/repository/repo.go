@@ -3,15 +3,21 @@ package repository
 import (
 	"database/sql"
 
+	pg_codeowners "github.com/3eLLenKa/test-avito/internal/repository/postgres/codeowners"
+	pg_outbox "github.com/3eLLenKa/test-avito/internal/repository/postgres/outbox"
 	pg_pr "github.com/3eLLenKa/test-avito/internal/repository/postgres/pr"
 	pg_team "github.com/3eLLenKa/test-avito/internal/repository/postgres/team"
 	pg_user "github.com/3eLLenKa/test-avito/internal/repository/postgres/user"
+	pg_webhook "github.com/3eLLenKa/test-avito/internal/repository/postgres/webhook"
 )
 
 type Repositories struct {
 	PullRequest *pg_pr.PRRepo
 	Team        *pg_team.TeamRepo
 	User        *pg_user.UserRepo
+	CodeOwners  *pg_codeowners.CodeOwnersRepo
+	Outbox      *pg_outbox.OutboxRepo
+	Webhooks    *pg_webhook.WebhookRepo
 }
 
 func New(db *sql.DB) *Repositories {
@@ -19,5 +25,8 @@ func New(db *sql.DB) *Repositories {
 		PullRequest: pg_pr.New(db),
 		Team:        pg_team.New(db),
 		User:        pg_user.New(db),
+		CodeOwners:  pg_codeowners.New(db),
+		Outbox:      pg_outbox.New(db),
+		Webhooks:    pg_webhook.New(db),
 	}
 }
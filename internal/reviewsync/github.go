@@ -0,0 +1,84 @@
+package reviewsync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// GitHubClient вызывает REST API requested_reviewers. baseURL можно подменить
+// на GitHub Enterprise или httptest-сервер.
+type GitHubClient struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func NewGitHubClient(baseURL, token string, timeout time.Duration) *GitHubClient {
+	if baseURL == "" {
+		baseURL = DefaultGitHubAPIURL
+	}
+	return &GitHubClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *GitHubClient) RequestReviewers(ctx context.Context, ref Ref, logins []string) error {
+	return c.do(ctx, http.MethodPost, ref, logins)
+}
+
+func (c *GitHubClient) RemoveReviewers(ctx context.Context, ref Ref, logins []string) error {
+	return c.do(ctx, http.MethodDelete, ref, logins)
+}
+
+func (c *GitHubClient) do(ctx context.Context, method string, ref Ref, logins []string) error {
+	body, err := json.Marshal(struct {
+		Reviewers []string `json:"reviewers"`
+	}{Reviewers: logins})
+	if err != nil {
+		return fmt.Errorf("failed to marshal reviewers: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/requested_reviewers", c.baseURL, ref.Owner, ref.Repo, ref.Number)
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build github request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call github %s %s: %w", method, ref, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("github %s %s responded with status %d: %s", method, ref, resp.StatusCode, strings.TrimSpace(string(msg)))
+	if retryable(resp.StatusCode) {
+		return err
+	}
+	return &PermanentError{Err: err}
+}
+
+// retryable: 403 GitHub отдаёт и при исчерпании rate limit, поэтому его тоже повторяем
+func retryable(status int) bool {
+	return status >= 500 || status == http.StatusTooManyRequests || status == http.StatusForbidden || status == http.StatusRequestTimeout
+}
@@ -0,0 +1,139 @@
+package reviewsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type githubRequest struct {
+	method    string
+	path      string
+	auth      string
+	reviewers []string
+}
+
+// newGitHubStub поднимает httptest-сервер вместо API GitHub; status — код ответа на все запросы
+func newGitHubStub(t *testing.T, status int) (*GitHubClient, *[]githubRequest) {
+	t.Helper()
+
+	var requests []githubRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reviewers []string `json:"reviewers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		requests = append(requests, githubRequest{
+			method:    r.Method,
+			path:      r.URL.Path,
+			auth:      r.Header.Get("Authorization"),
+			reviewers: body.Reviewers,
+		})
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"stub"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewGitHubClient(srv.URL+"/", "token", time.Second), &requests
+}
+
+func TestGitHubClientRequests(t *testing.T) {
+	client, requests := newGitHubStub(t, http.StatusCreated)
+	ref := Ref{Owner: "acme", Repo: "backend", Number: 42}
+
+	if err := client.RequestReviewers(context.Background(), ref, []string{"bob"}); err != nil {
+		t.Fatalf("RequestReviewers: %v", err)
+	}
+	if err := client.RemoveReviewers(context.Background(), ref, []string{"carol"}); err != nil {
+		t.Fatalf("RemoveReviewers: %v", err)
+	}
+
+	want := []githubRequest{
+		{method: http.MethodPost, path: "/repos/acme/backend/pulls/42/requested_reviewers", auth: "Bearer token", reviewers: []string{"bob"}},
+		{method: http.MethodDelete, path: "/repos/acme/backend/pulls/42/requested_reviewers", auth: "Bearer token", reviewers: []string{"carol"}},
+	}
+	if len(*requests) != len(want) {
+		t.Fatalf("requests = %+v", *requests)
+	}
+	for i, got := range *requests {
+		if got.method != want[i].method || got.path != want[i].path || got.auth != want[i].auth || !slices.Equal(got.reviewers, want[i].reviewers) {
+			t.Errorf("request %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestGitHubClientErrors(t *testing.T) {
+	tests := []struct {
+		status    int
+		permanent bool
+	}{
+		{status: http.StatusUnprocessableEntity, permanent: true},
+		{status: http.StatusNotFound, permanent: true},
+		{status: http.StatusForbidden, permanent: false},
+		{status: http.StatusTooManyRequests, permanent: false},
+		{status: http.StatusBadGateway, permanent: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client, _ := newGitHubStub(t, tt.status)
+
+			err := client.RequestReviewers(context.Background(), Ref{Owner: "acme", Repo: "backend", Number: 1}, []string{"bob"})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			var permanent *PermanentError
+			if errors.As(err, &permanent) != tt.permanent {
+				t.Errorf("permanent = %v, want %v (err: %v)", !tt.permanent, tt.permanent, err)
+			}
+		})
+	}
+}
+
+func TestSinkAgainstGitHubStub(t *testing.T) {
+	client, requests := newGitHubStub(t, http.StatusOK)
+	sink := NewSink(slog.New(slog.NewTextHandler(io.Discard, nil)), client,
+		map[string]string{"bob": "u2", "carol": "u3"}, []string{"acme/backend"})
+
+	publish := func(prId string, status domain.PullRequestStatus) {
+		t.Helper()
+		body, _ := json.Marshal(payload{
+			PullRequestID:    prId,
+			Status:           status,
+			AddedReviewers:   []string{"u2", "u9"},
+			RemovedReviewers: []string{"u3"},
+		})
+		if err := sink.Publish(context.Background(), domain.OutboxEvent{ID: 1, Payload: body}); err != nil {
+			t.Fatalf("Publish(%s): %v", prId, err)
+		}
+	}
+
+	publish("acme/backend#42", domain.PRStatusOpen)
+	// чужой репозиторий, не GitHub-ID и слитый PR пропускаются
+	publish("acme/frontend#7", domain.PRStatusOpen)
+	publish("pr-1001", domain.PRStatusOpen)
+	publish("acme/backend#43", domain.PRStatusMerged)
+
+	if len(*requests) != 2 {
+		t.Fatalf("requests = %+v", *requests)
+	}
+	// сначала отзыв, затем запрос; u9 без логина пропущен
+	if r := (*requests)[0]; r.method != http.MethodDelete || !slices.Equal(r.reviewers, []string{"carol"}) {
+		t.Errorf("first request = %+v", r)
+	}
+	if r := (*requests)[1]; r.method != http.MethodPost || !slices.Equal(r.reviewers, []string{"bob"}) {
+		t.Errorf("second request = %+v", r)
+	}
+}
@@ -0,0 +1,152 @@
+package reviewsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// Ref — PR на Git-хостинге
+type Ref struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// ParseRef разбирает ID PR вида "<owner>/<repo>#<number>", который создаёт интеграция с GitHub
+func ParseRef(prId string) (Ref, bool) {
+	repo, num, ok := strings.Cut(prId, "#")
+	if !ok {
+		return Ref{}, false
+	}
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return Ref{}, false
+	}
+	n, err := strconv.Atoi(num)
+	if err != nil || n <= 0 {
+		return Ref{}, false
+	}
+	return Ref{Owner: owner, Repo: name, Number: n}, true
+}
+
+// ReviewerSyncer запрашивает и отзывает ревью на Git-хостинге. Логины — учётные записи хостинга.
+type ReviewerSyncer interface {
+	RequestReviewers(ctx context.Context, ref Ref, logins []string) error
+	RemoveReviewers(ctx context.Context, ref Ref, logins []string) error
+}
+
+// PermanentError — ошибка, которую бесполезно повторять (например, ревьювер не коллаборатор репозитория)
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Sink переносит изменения состава ревьюверов из outbox на хостинг.
+// Временные ошибки возвращаются relay, и событие повторяется с backoff;
+// постоянные только логируются, чтобы не блокировать событие.
+type Sink struct {
+	log    *slog.Logger
+	syncer ReviewerSyncer
+	logins map[string]string
+	repos  []string
+}
+
+// NewSink: users — логин на хостинге -> user_id (как в конфиге интеграции);
+// repos — "<owner>/<repo>", которые живут на этом хостинге, пустой список — все
+func NewSink(log *slog.Logger, syncer ReviewerSyncer, users map[string]string, repos []string) *Sink {
+	logins := make(map[string]string, len(users))
+	for login, userId := range users {
+		logins[userId] = login
+	}
+	return &Sink{
+		log:    log,
+		syncer: syncer,
+		logins: logins,
+		repos:  repos,
+	}
+}
+
+func (s *Sink) Name() string {
+	return "reviewer_sync"
+}
+
+type payload struct {
+	PullRequestID    string                   `json:"pull_request_id"`
+	Status           domain.PullRequestStatus `json:"status"`
+	AddedReviewers   []string                 `json:"added_reviewers"`
+	RemovedReviewers []string                 `json:"removed_reviewers"`
+}
+
+func (s *Sink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	var p payload
+	if err := json.Unmarshal(event.Payload, &p); err != nil {
+		return fmt.Errorf("failed to decode payload of event %d: %w", event.ID, err)
+	}
+
+	// у закрытого или слитого PR запрашивать ревью уже незачем
+	if p.Status == domain.PRStatusClosed || p.Status == domain.PRStatusMerged {
+		return nil
+	}
+
+	ref, ok := ParseRef(p.PullRequestID)
+	if !ok || (len(s.repos) > 0 && !slices.Contains(s.repos, ref.Owner+"/"+ref.Repo)) {
+		return nil
+	}
+
+	// сначала отзываем снятых ревьюверов, затем запрашиваем новых; оба вызова идемпотентны
+	if removed := s.toLogins(p.RemovedReviewers); len(removed) > 0 {
+		if err := s.check(event, ref, s.syncer.RemoveReviewers(ctx, ref, removed)); err != nil {
+			return err
+		}
+	}
+	if added := s.toLogins(p.AddedReviewers); len(added) > 0 {
+		if err := s.check(event, ref, s.syncer.RequestReviewers(ctx, ref, added)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check логирует и гасит постоянные ошибки, временные возвращает для повтора
+func (s *Sink) check(event domain.OutboxEvent, ref Ref, err error) error {
+	var permanent *PermanentError
+	if errors.As(err, &permanent) {
+		s.log.Warn("reviewsync: host rejected reviewers change",
+			slog.Int64("event_id", event.ID),
+			slog.String("pr", ref.String()),
+			slog.Any("err", err),
+		)
+		return nil
+	}
+	return err
+}
+
+// toLogins пропускает пользователей без логина на хостинге
+func (s *Sink) toLogins(userIDs []string) []string {
+	logins := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if login, ok := s.logins[id]; ok {
+			logins = append(logins, login)
+		}
+	}
+	return logins
+}
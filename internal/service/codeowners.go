@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/codeowners"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// CodeOwnersSet разбирает файл в формате CODEOWNERS и целиком заменяет им сохранённые правила
+func (s *Service) CodeOwnersSet(ctx context.Context, content string) ([]domain.CodeOwnerRule, error) {
+	rules, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.owners.SetRules(ctx, rules)
+	if err != nil {
+		s.log.Error("service.CodeOwnersSet: failed to save rules in repo", slog.Any("error", err))
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (s *Service) CodeOwnersGet(ctx context.Context) ([]domain.CodeOwnerRule, error) {
+	rules, err := s.owners.ListRules(ctx)
+	if err != nil {
+		s.log.Error("service.CodeOwnersGet: failed to list rules from repo", slog.Any("error", err))
+		return nil, err
+	}
+	return rules, nil
+}
+
+// pickOwners выбирает до count ревьюверов среди владельцев изменённых путей PR
+func (s *Service) pickOwners(ctx context.Context, pr *domain.PullRequest, policy domain.TeamPolicy, exclude map[string]bool, count int) ([]domain.User, bool, error) {
+	picked := make([]domain.User, 0, count)
+	if len(pr.ChangedPaths) == 0 || count <= 0 {
+		return picked, false, nil
+	}
+
+	owners, err := s.pathOwners(ctx, pr.ChangedPaths, policy.AssignOnlyActive)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to resolve code owners: %w", err)
+	}
+
+	byTeam := make(map[string][]domain.User)
+	teams := make([]string, 0)
+	for _, o := range owners {
+		if exclude[o.ID] {
+			continue
+		}
+		if _, ok := byTeam[o.TeamName]; !ok {
+			teams = append(teams, o.TeamName)
+		}
+		byTeam[o.TeamName] = append(byTeam[o.TeamName], o)
+	}
+
+	candidates := make([]domain.User, 0, len(owners))
+	saturated := false
+	for _, teamName := range teams {
+		limit, err := s.teamCapacity(ctx, policy, teamName)
+		if err != nil {
+			return nil, false, err
+		}
+
+		free, dropped, err := s.withinCapacity(ctx, byTeam[teamName], limit)
+		if err != nil {
+			return nil, false, err
+		}
+		saturated = saturated || dropped
+		candidates = append(candidates, free...)
+	}
+
+	users, err := s.picker.Pick(ctx, s.pickRand(pr.PullRequestId, candidates), ownersPoolKey(policy.TeamName), candidates, count)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, u := range users {
+		exclude[u.ID] = true
+	}
+	return append(picked, users...), saturated, nil
+}
+
+// pathOwners возвращает доступных для назначения владельцев путей: пользователей
+// из правил и участников команд из правил. Неизвестные пользователи пропускаются.
+func (s *Service) pathOwners(ctx context.Context, paths []string, onlyActive bool) ([]domain.User, error) {
+	rules, err := s.owners.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make(map[string]bool)
+	teamNames := make(map[string]bool)
+	for _, p := range paths {
+		rule, ok := codeowners.Owners(rules, p)
+		if !ok {
+			continue
+		}
+		for _, id := range rule.Users {
+			userIDs[id] = true
+		}
+		for _, name := range rule.Teams {
+			teamNames[name] = true
+		}
+	}
+
+	members := make(map[string][]domain.User)
+	teamMembers := func(teamName string) ([]domain.User, error) {
+		if m, ok := members[teamName]; ok {
+			return m, nil
+		}
+		m, err := s.teamCandidates(ctx, teamName, onlyActive)
+		if err != nil {
+			return nil, err
+		}
+		members[teamName] = m
+		return m, nil
+	}
+
+	for id := range userIDs {
+		user, err := s.user.GetUserById(ctx, id)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			s.log.Warn("service.pathOwners: code owner not found", slog.String("user_id", id))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := teamMembers(user.TeamName); err != nil {
+			return nil, err
+		}
+	}
+	for name := range teamNames {
+		if _, err := teamMembers(name); err != nil {
+			return nil, err
+		}
+	}
+
+	owners := make([]domain.User, 0)
+	for teamName, m := range members {
+		for _, u := range m {
+			if teamNames[teamName] || userIDs[u.ID] {
+				owners = append(owners, u)
+			}
+		}
+	}
+	return sortedByID(owners), nil
+}
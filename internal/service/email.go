@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"net/mail"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// UserSetEmail задаёт адрес для уведомлений; пустая строка отключает письма
+func (s *Service) UserSetEmail(ctx context.Context, userId string, email string) (*domain.User, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.user.SetEmail(ctx, userId, email)
+	if err != nil {
+		s.log.Error("service.UserSetEmail: failed to update email in repo", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
+	}
+	return user, nil
+}
+
+// ReviewDigests собирает ожидающие ревью (как UsersGetReview с pending_only, от старых к новым,
+// не больше maxInboxLimit) для всех активных пользователей с email; пользователи без ожидающих ревью пропускаются
+func (s *Service) ReviewDigests(ctx context.Context) ([]domain.ReviewDigest, error) {
+	users, err := s.user.ListUsersWithEmail(ctx)
+	if err != nil {
+		s.log.Error("service.ReviewDigests: failed to list users with email", slog.Any("error", err))
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	digests := make([]domain.ReviewDigest, 0)
+	for _, u := range users {
+		pending, err := s.pr.ListReviewInbox(ctx, domain.ReviewInboxQuery{
+			UserID:      u.ID,
+			PendingOnly: true,
+			Ascending:   true,
+			Limit:       maxInboxLimit,
+		})
+		if err != nil {
+			s.log.Error("service.ReviewDigests: failed to list pending reviews from repo", slog.String("user_id", u.ID), slog.Any("error", err))
+			return nil, err
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		digests = append(digests, domain.ReviewDigest{User: u, PullRequests: pending})
+	}
+	return digests, nil
+}
+
+// normalizeEmail оставляет только адрес без отображаемого имени
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", nil
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" {
+		return "", domain.ErrInvalidEmail
+	}
+	return addr.Address, nil
+}
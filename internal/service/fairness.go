@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// StatsFairness оценивает, насколько равномерно распределены назначения среди активных участников
+// команды за окно; пустой teamName — по всем командам
+func (s *Service) StatsFairness(ctx context.Context, teamName string, from, to *time.Time) (*domain.FairnessReport, error) {
+	start, end, err := statsWindow(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if teamName != "" {
+		if _, err := s.team.GetTeam(ctx, teamName); err != nil {
+			if !errors.Is(err, domain.ErrTeamNotFound) {
+				s.log.Error("service.StatsFairness: failed to get team", slog.String("team_name", teamName), slog.Any("error", err))
+			}
+			return nil, err
+		}
+	}
+
+	teams, err := s.fairnessReports(ctx, teamName, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.FairnessReport{From: start, To: end, Teams: teams}, nil
+}
+
+// CheckFairness считает отчёт за последние window по всем командам и сохраняет состояние перекоса.
+// Возвращает команды, которые только что перешли порог (для них в outbox записано предупреждение).
+func (s *Service) CheckFairness(ctx context.Context, now time.Time, window time.Duration) ([]domain.TeamFairness, error) {
+	reports, err := s.fairnessReports(ctx, "", now.Add(-window), now)
+	if err != nil {
+		return nil, err
+	}
+
+	var crossed []domain.TeamFairness
+	for _, report := range reports {
+		ok, err := s.team.UpdateSkewState(ctx, report, now)
+		if err != nil {
+			s.log.Error("service.CheckFairness: failed to update skew state", slog.String("team_name", report.TeamName), slog.Any("error", err))
+			return crossed, err
+		}
+		if ok {
+			crossed = append(crossed, report)
+		}
+	}
+	return crossed, nil
+}
+
+func (s *Service) fairnessReports(ctx context.Context, teamName string, from, to time.Time) ([]domain.TeamFairness, error) {
+	counts, err := s.pr.MemberAssignmentCounts(ctx, teamName, from, to)
+	if err != nil {
+		s.log.Error("service.fairnessReports: failed to count member assignments", slog.String("team_name", teamName), slog.Any("error", err))
+		return nil, err
+	}
+
+	// counts отсортированы по команде
+	reports := make([]domain.TeamFairness, 0)
+	for i := 0; i < len(counts); {
+		j := i
+		for j < len(counts) && counts[j].TeamName == counts[i].TeamName {
+			j++
+		}
+		report := s.teamFairness(counts[i:j])
+		report.From, report.To = from, to
+		reports = append(reports, report)
+		i = j
+	}
+	return reports, nil
+}
+
+// teamFairness считает Gini и отношение max/min по назначениям участников одной команды.
+// Перегруженные — выше среднего больше чем на Tolerance, недогруженные — ниже на столько же.
+func (s *Service) teamFairness(members []domain.MemberAssignments) domain.TeamFairness {
+	report := domain.TeamFairness{
+		TeamName:      members[0].TeamName,
+		MembersCount:  len(members),
+		Threshold:     s.fairness.GiniThreshold,
+		OverAssigned:  make([]domain.MemberAssignments, 0),
+		UnderAssigned: make([]domain.MemberAssignments, 0),
+	}
+
+	values := make([]int, 0, len(members))
+	for _, m := range members {
+		values = append(values, m.AssignmentsCount)
+		report.AssignmentsCount += m.AssignmentsCount
+	}
+	if report.AssignmentsCount == 0 {
+		return report
+	}
+
+	report.Mean = float64(report.AssignmentsCount) / float64(len(members))
+	report.Gini = gini(values)
+	report.Skewed = len(members) > 1 && report.Gini > s.fairness.GiniThreshold
+
+	lo, hi := slices.Min(values), slices.Max(values)
+	if lo > 0 {
+		ratio := float64(hi) / float64(lo)
+		report.MaxMinRatio = &ratio
+	}
+
+	for _, m := range members {
+		switch count := float64(m.AssignmentsCount); {
+		case count > report.Mean*(1+s.fairness.Tolerance):
+			report.OverAssigned = append(report.OverAssigned, m)
+		case count < report.Mean*(1-s.fairness.Tolerance):
+			report.UnderAssigned = append(report.UnderAssigned, m)
+		}
+	}
+	return report
+}
+
+// gini — коэффициент Джини: 0 — все получили поровну, ближе к 1 — всё досталось одному
+func gini(values []int) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var total, weighted float64
+	for i, v := range sorted {
+		total += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	if total == 0 {
+		return 0
+	}
+
+	n := float64(len(sorted))
+	return 2*weighted/(n*total) - (n+1)/n
+}
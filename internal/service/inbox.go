@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const (
+	defaultInboxLimit = 50
+	maxInboxLimit     = 500
+)
+
+// UsersGetReview возвращает страницу PR, где пользователь назначен ревьювером, и курсор следующей
+// страницы (пустой — страниц больше нет). cursor — значение, полученное с предыдущей страницы.
+// При PendingOnly остаются только открытые PR, по которым он ещё не вынес вердикт.
+func (s *Service) UsersGetReview(ctx context.Context, q domain.ReviewInboxQuery, cursor string) ([]*domain.PullRequest, string, error) {
+	if err := checkStatusFilter(q.Status); err != nil {
+		return nil, "", err
+	}
+	if cursor != "" {
+		after, err := decodeInboxCursor(cursor, q)
+		if err != nil {
+			return nil, "", err
+		}
+		q.After = after
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = defaultInboxLimit
+	}
+	q.Limit = min(q.Limit, maxInboxLimit)
+
+	if _, err := s.user.GetUserById(ctx, q.UserID); err != nil {
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			s.log.Error("service.UsersGetReview: failed to get user", slog.String("user_id", q.UserID), slog.Any("error", err))
+		}
+		return nil, "", err
+	}
+
+	// запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	limit := q.Limit
+	q.Limit++
+	prs, err := s.pr.ListReviewInbox(ctx, q)
+	if err != nil {
+		s.log.Error("service.UsersGetReview: failed to list review inbox", slog.String("user_id", q.UserID), slog.Any("error", err))
+		return nil, "", err
+	}
+
+	next := ""
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[limit-1]
+		next = encodeInboxCursor(q, domain.ReviewInboxCursor{CreatedAt: *last.CreatedAt, PullRequestID: last.PullRequestId})
+	}
+	return prs, next, nil
+}
+
+// inboxCursor — позиция в выдаче вместе с параметрами запроса, для которых она выдана:
+// keyset-условие зависит от порядка и фильтров, поэтому курсор с другими параметрами отвергается
+type inboxCursor struct {
+	UserID        string                   `json:"u"`
+	Ascending     bool                     `json:"asc,omitempty"`
+	PendingOnly   bool                     `json:"pending,omitempty"`
+	Status        domain.PullRequestStatus `json:"status,omitempty"`
+	CreatedAt     time.Time                `json:"at"`
+	PullRequestID string                   `json:"id"`
+}
+
+func (c inboxCursor) matches(q domain.ReviewInboxQuery) bool {
+	return c.UserID == q.UserID && c.Ascending == q.Ascending && c.PendingOnly == q.PendingOnly && c.Status == q.Status
+}
+
+// курсор — base64url от JSON inboxCursor
+func encodeInboxCursor(q domain.ReviewInboxQuery, c domain.ReviewInboxCursor) string {
+	raw, _ := json.Marshal(inboxCursor{
+		UserID:        q.UserID,
+		Ascending:     q.Ascending,
+		PendingOnly:   q.PendingOnly,
+		Status:        q.Status,
+		CreatedAt:     c.CreatedAt.UTC(),
+		PullRequestID: c.PullRequestID,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeInboxCursor(cursor string, q domain.ReviewInboxQuery) (*domain.ReviewInboxCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var c inboxCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.PullRequestID == "" || c.CreatedAt.IsZero() {
+		return nil, domain.ErrInvalidCursor
+	}
+	if !c.matches(q) {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &domain.ReviewInboxCursor{CreatedAt: c.CreatedAt, PullRequestID: c.PullRequestID}, nil
+}
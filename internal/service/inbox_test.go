@@ -0,0 +1,50 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+func TestInboxCursorRoundTrip(t *testing.T) {
+	q := domain.ReviewInboxQuery{UserID: "u1", PendingOnly: true, Ascending: true}
+	pos := domain.ReviewInboxCursor{
+		CreatedAt:     time.Date(2025, 12, 1, 10, 0, 0, 123456789, time.UTC),
+		PullRequestID: "acme/backend#42",
+	}
+
+	got, err := decodeInboxCursor(encodeInboxCursor(q, pos), q)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !got.CreatedAt.Equal(pos.CreatedAt) || got.PullRequestID != pos.PullRequestID {
+		t.Errorf("cursor = %+v, want %+v", got, pos)
+	}
+}
+
+func TestInboxCursorRejected(t *testing.T) {
+	issued := domain.ReviewInboxQuery{UserID: "u1", Status: domain.PRStatusOpen}
+	cursor := encodeInboxCursor(issued, domain.ReviewInboxCursor{CreatedAt: time.Now(), PullRequestID: "pr-1"})
+
+	tests := []struct {
+		name   string
+		cursor string
+		q      domain.ReviewInboxQuery
+	}{
+		{name: "not base64", cursor: "%%%", q: issued},
+		{name: "not json", cursor: "bm90LWpzb24", q: issued},
+		{name: "other user", cursor: cursor, q: domain.ReviewInboxQuery{UserID: "u2", Status: domain.PRStatusOpen}},
+		{name: "other order", cursor: cursor, q: domain.ReviewInboxQuery{UserID: "u1", Status: domain.PRStatusOpen, Ascending: true}},
+		{name: "other status", cursor: cursor, q: domain.ReviewInboxQuery{UserID: "u1", Status: domain.PRStatusMerged}},
+		{name: "pending only", cursor: cursor, q: domain.ReviewInboxQuery{UserID: "u1", Status: domain.PRStatusOpen, PendingOnly: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeInboxCursor(tt.cursor, tt.q); !errors.Is(err, domain.ErrInvalidCursor) {
+				t.Errorf("err = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyRoundRobin  = "round_robin"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyWeighted    = "weighted"
+)
+
+const defaultReviewerWeight = 1
+
+// ReviewerPicker выбирает до count ревьюверов из списка кандидатов.
+// Кандидаты уже отфильтрованы сервисом (автор, неактивные, уже назначенные).
+// Вся случайность берётся из rnd, чтобы выбор можно было воспроизвести.
+// pool — имя пула кандидатов (команда или владельцы кода команды); по нему стратегии
+// с состоянием, как round_robin, отделяют очереди разных пулов.
+type ReviewerPicker interface {
+	Pick(ctx context.Context, rnd *rand.Rand, pool string, candidates []domain.User, count int) ([]domain.User, error)
+}
+
+// teamPoolKey и ownersPoolKey — имена пулов для ReviewerPicker
+func teamPoolKey(teamName string) string {
+	return "team:" + teamName
+}
+
+func ownersPoolKey(teamName string) string {
+	return "codeowners:" + teamName
+}
+
+type ReviewLoadCounter interface {
+	CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error)
+}
+
+func NewReviewerPicker(strategy string, weights map[string]int, loads ReviewLoadCounter) (ReviewerPicker, error) {
+	switch strategy {
+	case "", StrategyRandom:
+		return &RandomPicker{}, nil
+	case StrategyRoundRobin:
+		return NewRoundRobinPicker(), nil
+	case StrategyLeastLoaded:
+		return NewLeastLoadedPicker(loads), nil
+	case StrategyWeighted:
+		return NewWeightedPicker(weights), nil
+	default:
+		return nil, fmt.Errorf("unknown reviewer picker strategy %q", strategy)
+	}
+}
+
+type RandomPicker struct{}
+
+func (p *RandomPicker) Pick(_ context.Context, rnd *rand.Rand, _ string, candidates []domain.User, count int) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	users := sortedByID(candidates)
+	rnd.Shuffle(len(users), func(i, j int) { users[i], users[j] = users[j], users[i] })
+
+	return firstN(users, count), nil
+}
+
+// RoundRobinPicker запоминает последнего выбранного ревьювера в каждом пуле
+// и продолжает обход с него. Курсор живёт только в памяти процесса: после
+// перезапуска обход начинается заново, а у каждой реплики сервиса он свой.
+type RoundRobinPicker struct {
+	mu   sync.Mutex
+	last map[string]string
+}
+
+func NewRoundRobinPicker() *RoundRobinPicker {
+	return &RoundRobinPicker{last: make(map[string]string)}
+}
+
+func (p *RoundRobinPicker) Pick(_ context.Context, _ *rand.Rand, pool string, candidates []domain.User, count int) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	users := sortedByID(candidates)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	start := 0
+	if lastID, ok := p.last[pool]; ok {
+		start = sort.Search(len(users), func(i int) bool { return users[i].ID > lastID })
+	}
+
+	n := min(count, len(users))
+	picked := make([]domain.User, 0, n)
+	for i := 0; i < n; i++ {
+		picked = append(picked, users[(start+i)%len(users)])
+	}
+	p.last[pool] = picked[len(picked)-1].ID
+
+	return picked, nil
+}
+
+// LeastLoadedPicker отдаёт предпочтение кандидатам с наименьшим
+// количеством открытых PR на ревью. При равной загрузке порядок случайный.
+type LeastLoadedPicker struct {
+	loads ReviewLoadCounter
+}
+
+func NewLeastLoadedPicker(loads ReviewLoadCounter) *LeastLoadedPicker {
+	return &LeastLoadedPicker{loads: loads}
+}
+
+func (p *LeastLoadedPicker) Pick(ctx context.Context, rnd *rand.Rand, _ string, candidates []domain.User, count int) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	counts, err := p.loads.CountOpenReviews(ctx, reviewersIds(candidates))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count open reviews: %w", err)
+	}
+
+	users := sortedByID(candidates)
+	rnd.Shuffle(len(users), func(i, j int) { users[i], users[j] = users[j], users[i] })
+	sort.SliceStable(users, func(i, j int) bool { return counts[users[i].ID] < counts[users[j].ID] })
+
+	return firstN(users, count), nil
+}
+
+// WeightedPicker выбирает кандидатов случайно без повторов
+// с вероятностью, пропорциональной весу пользователя.
+type WeightedPicker struct {
+	weights map[string]int
+}
+
+func NewWeightedPicker(weights map[string]int) *WeightedPicker {
+	return &WeightedPicker{weights: weights}
+}
+
+func (p *WeightedPicker) Pick(_ context.Context, rnd *rand.Rand, _ string, candidates []domain.User, count int) ([]domain.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	pool := sortedByID(candidates)
+	n := min(count, len(pool))
+	picked := make([]domain.User, 0, n)
+
+	for len(picked) < n {
+		total := 0
+		for _, u := range pool {
+			total += p.weight(u.ID)
+		}
+
+		idx := len(pool) - 1
+		if total > 0 {
+			r := rnd.Intn(total)
+			for i, u := range pool {
+				r -= p.weight(u.ID)
+				if r < 0 {
+					idx = i
+					break
+				}
+			}
+		}
+
+		picked = append(picked, pool[idx])
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+
+	return picked, nil
+}
+
+func (p *WeightedPicker) weight(userID string) int {
+	w, ok := p.weights[userID]
+	if !ok {
+		return defaultReviewerWeight
+	}
+	if w < 0 {
+		return 0
+	}
+	return w
+}
+
+func sortedByID(users []domain.User) []domain.User {
+	res := append([]domain.User(nil), users...)
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func firstN(users []domain.User, count int) []domain.User {
+	if len(users) < count {
+		return users
+	}
+	return users[:count]
+}
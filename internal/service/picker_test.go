@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+func users(team string, ids ...string) []domain.User {
+	res := make([]domain.User, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, domain.User{ID: id, Name: id, TeamName: team, IsActive: true})
+	}
+	return res
+}
+
+func ids(users []domain.User) []string {
+	return reviewersIds(users)
+}
+
+func TestRoundRobinPickerRotates(t *testing.T) {
+	p := NewRoundRobinPicker()
+	pool := teamPoolKey("backend")
+	// порядок кандидатов на входе не важен: обход идёт по user_id
+	candidates := users("backend", "u3", "u1", "u4", "u2")
+
+	want := [][]string{
+		{"u1", "u2"},
+		{"u3", "u4"},
+		{"u1", "u2"},
+		{"u3"},
+		{"u4", "u1", "u2"},
+	}
+	counts := []int{2, 2, 2, 1, 3}
+	for i, count := range counts {
+		got, err := p.Pick(context.Background(), nil, pool, candidates, count)
+		if err != nil {
+			t.Fatalf("Pick: %v", err)
+		}
+		if !slices.Equal(ids(got), want[i]) {
+			t.Errorf("pick %d = %v, want %v", i, ids(got), want[i])
+		}
+	}
+}
+
+func TestRoundRobinPickerContinuesAfterLastWhenPoolChanges(t *testing.T) {
+	p := NewRoundRobinPicker()
+	pool := teamPoolKey("backend")
+
+	if _, err := p.Pick(context.Background(), nil, pool, users("backend", "u1", "u2", "u4"), 2); err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	// u2 был последним; u3 появился, u4 ушёл в отпуск — обход продолжается со следующего после u2
+	got, err := p.Pick(context.Background(), nil, pool, users("backend", "u1", "u2", "u3"), 2)
+	if err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if !slices.Equal(ids(got), []string{"u3", "u1"}) {
+		t.Errorf("pick = %v, want [u3 u1]", ids(got))
+	}
+}
+
+func TestRoundRobinPickerSeparatesPools(t *testing.T) {
+	p := NewRoundRobinPicker()
+	ctx := context.Background()
+
+	// резервная команда и владельцы кода не сдвигают очередь основной команды,
+	// даже если кандидаты из разных команд
+	mixed := append(users("backend", "u1"), users("frontend", "u5", "u6")...)
+	steps := []struct {
+		pool  string
+		cands []domain.User
+		want  []string
+	}{
+		{teamPoolKey("backend"), users("backend", "u1", "u2", "u3"), []string{"u1"}},
+		{teamPoolKey("frontend"), users("frontend", "u5", "u6"), []string{"u5"}},
+		{ownersPoolKey("backend"), mixed, []string{"u1"}},
+		{teamPoolKey("backend"), users("backend", "u1", "u2", "u3"), []string{"u2"}},
+		{ownersPoolKey("backend"), mixed, []string{"u5"}},
+		{teamPoolKey("frontend"), users("frontend", "u5", "u6"), []string{"u6"}},
+	}
+	for i, step := range steps {
+		got, err := p.Pick(ctx, nil, step.pool, step.cands, 1)
+		if err != nil {
+			t.Fatalf("Pick: %v", err)
+		}
+		if !slices.Equal(ids(got), step.want) {
+			t.Errorf("step %d (%s) = %v, want %v", i, step.pool, ids(got), step.want)
+		}
+	}
+}
+
+func TestWeightedPickerZeroAndNegativeWeights(t *testing.T) {
+	candidates := users("backend", "u4", "u3", "u2", "u1")
+
+	tests := []struct {
+		name    string
+		weights map[string]int
+		count   int
+		want    []string
+	}{
+		{
+			// у u1 вес по умолчанию, u2 и u3 исключены весом, выбор между u1 и u4 решает rand
+			name:    "zero and negative skipped",
+			weights: map[string]int{"u2": 0, "u3": -3, "u4": 2},
+			count:   2,
+			want:    []string{"u4", "u1"},
+		},
+		{
+			// когда положительные веса кончились, добираем с конца списка по user_id
+			name:    "exhausted positive weights",
+			weights: map[string]int{"u2": 0, "u3": -3, "u4": 2},
+			count:   4,
+			want:    []string{"u4", "u1", "u3", "u2"},
+		},
+		{
+			name:    "all zero",
+			weights: map[string]int{"u1": 0, "u2": -1, "u3": 0, "u4": 0},
+			count:   2,
+			want:    []string{"u4", "u3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewWeightedPicker(tt.weights)
+			got, err := p.Pick(context.Background(), rand.New(rand.NewSource(42)), "team:backend", candidates, tt.count)
+			if err != nil {
+				t.Fatalf("Pick: %v", err)
+			}
+			if !slices.Equal(ids(got), tt.want) {
+				t.Errorf("picked %v, want %v", ids(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedPickerNeverPicksZeroWeight(t *testing.T) {
+	p := NewWeightedPicker(map[string]int{"u2": 0, "u3": -3})
+	rnd := rand.New(rand.NewSource(7))
+	candidates := users("backend", "u1", "u2", "u3", "u4", "u5")
+
+	for range 1000 {
+		got, err := p.Pick(context.Background(), rnd, "team:backend", candidates, 3)
+		if err != nil {
+			t.Fatalf("Pick: %v", err)
+		}
+		for _, id := range ids(got) {
+			if id == "u2" || id == "u3" {
+				t.Fatalf("picked %v with zero weight", ids(got))
+			}
+		}
+	}
+}
+
+func TestPickersHandleEmptyInput(t *testing.T) {
+	pickers := map[string]ReviewerPicker{
+		StrategyRandom:      &RandomPicker{},
+		StrategyRoundRobin:  NewRoundRobinPicker(),
+		StrategyLeastLoaded: NewLeastLoadedPicker(fakeLoads{}),
+		StrategyWeighted:    NewWeightedPicker(nil),
+	}
+	for name, p := range pickers {
+		rnd := rand.New(rand.NewSource(1))
+		if got, _ := p.Pick(context.Background(), rnd, "team:x", nil, 2); len(got) != 0 {
+			t.Errorf("%s: picked %v from no candidates", name, ids(got))
+		}
+		if got, _ := p.Pick(context.Background(), rnd, "team:x", users("x", "u1"), 0); len(got) != 0 {
+			t.Errorf("%s: picked %v with count 0", name, ids(got))
+		}
+	}
+}
+
+type fakeLoads map[string]int
+
+func (f fakeLoads) CountOpenReviews(_ context.Context, userIDs []string) (map[string]int, error) {
+	res := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		res[id] = f[id]
+	}
+	return res, nil
+}
@@ -4,86 +4,278 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
 	"math/rand"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/3eLLenKa/test-avito/internal/config"
 	"github.com/3eLLenKa/test-avito/internal/domain"
 )
 
 type PullRequestRepo interface {
-	Create(ctx context.Context, prId, prName, authorId string, reviewers []string, createdAt time.Time) (*domain.PullRequest, error)
-	UpdatePR(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error)
+	Create(ctx context.Context, pr *domain.PullRequest, audit domain.AssignmentAudit) (*domain.PullRequest, error)
+	UpdatePR(ctx context.Context, pr *domain.PullRequest, audit domain.AssignmentAudit, history ...domain.PRHistoryEntry) (*domain.PullRequest, error)
 	GetPR(ctx context.Context, prId string) (*domain.PullRequest, error)
-	Reassign(ctx context.Context, prId, oldUserId, newUserId string) (*domain.PullRequest, error)
+	Reassign(ctx context.Context, prId, oldUserId, newUserId string, audit domain.AssignmentAudit) (*domain.PullRequest, error)
+	ListAssignmentEvents(ctx context.Context, prId string) ([]domain.AssignmentEvent, error)
+	ListAssignmentEventsByUser(ctx context.Context, userId string) ([]domain.AssignmentEvent, error)
 	ListPRs(ctx context.Context) ([]*domain.PullRequest, error)
 	ListOpenPRsByReviewers(ctx context.Context, deactivatedUserIDs []string) ([]*domain.PullRequest, error)
+	CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error)
+	SetReviewState(ctx context.Context, prId, reviewerId string, state domain.ReviewState, at time.Time) (*domain.PullRequest, error)
+	ListStaleReviews(ctx context.Context, assignedBefore time.Time) ([]domain.StaleReview, error)
+	RecordReminder(ctx context.Context, prId, reviewerId string, at time.Time) error
+	LatencyStats(ctx context.Context, from, to time.Time) (*domain.LatencyStats, error)
+	AssignmentStats(ctx context.Context, filter domain.StatsFilter) (*domain.AssignmentStats, error)
+	MemberAssignmentCounts(ctx context.Context, teamName string, from, to time.Time) ([]domain.MemberAssignments, error)
+	ListReviewInbox(ctx context.Context, q domain.ReviewInboxQuery) ([]*domain.PullRequest, error)
 }
 
 type TeamRepo interface {
 	Add(ctx context.Context, teamName string, members []domain.User) (*domain.Team, error)
 	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
+	SetPolicy(ctx context.Context, policy domain.TeamPolicy) (*domain.TeamPolicy, error)
+	GetPolicy(ctx context.Context, teamName string) (*domain.TeamPolicy, error)
+	SetFallbacks(ctx context.Context, teamName string, fallbacks []string) ([]string, error)
+	GetFallbacks(ctx context.Context, teamName string) ([]string, error)
+	UpdateSkewState(ctx context.Context, report domain.TeamFairness, at time.Time) (bool, error)
 }
 
 type UserRepo interface {
 	SetUserActive(ctx context.Context, userId string, isActive bool) (*domain.User, error)
 	GetUserById(ctx context.Context, userId string) (*domain.User, error)
-	ListActiveMembersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]domain.User, error)
+	ListActiveMembersByTeam(ctx context.Context, teamName string, excludeUserID string, at time.Time) ([]domain.User, error)
+	ListAvailableMembersByTeam(ctx context.Context, teamName string, excludeUserID string, at time.Time) ([]domain.User, error)
 	DeactivateByTeam(ctx context.Context, teamName string) ([]string, error)
+	SetMaxOpenReviews(ctx context.Context, userId string, limit *int) (*domain.User, error)
+	SetTags(ctx context.Context, userId string, tags []string) (*domain.User, error)
+	SetEmail(ctx context.Context, userId string, email string) (*domain.User, error)
+	ListUsersWithEmail(ctx context.Context) ([]domain.User, error)
+	ListTags(ctx context.Context, userIDs []string) (map[string][]string, error)
+	AddUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error)
+	UpdateUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) error
+	ListUnavailability(ctx context.Context, userId string) ([]domain.Unavailability, error)
+}
+
+type CodeOwnersRepo interface {
+	SetRules(ctx context.Context, rules []domain.CodeOwnerRule) ([]domain.CodeOwnerRule, error)
+	ListRules(ctx context.Context) ([]domain.CodeOwnerRule, error)
 }
 
 type Service struct {
-	log  *slog.Logger
-	pr   PullRequestRepo
-	team TeamRepo
-	user UserRepo
+	log    *slog.Logger
+	pr     PullRequestRepo
+	team   TeamRepo
+	user   UserRepo
+	owners CodeOwnersRepo
+	hooks  WebhookRepo
+	picker ReviewerPicker
+	cfg    config.PR
+
+	fairness   config.Fairness
+	dispatcher WebhookDispatcher
+
+	rndMu sync.Mutex
+	rnd   *rand.Rand
+}
+
+type Option func(*Service)
+
+func WithPicker(picker ReviewerPicker) Option {
+	return func(s *Service) {
+		s.picker = picker
+	}
+}
+
+// WithConfig задаёт политику назначения по умолчанию для команд без собственной политики.
+func WithConfig(cfg config.PR) Option {
+	return func(s *Service) {
+		s.cfg = cfg
+	}
+}
+
+// WithFairness задаёт пороги отчёта о равномерности назначений
+func WithFairness(cfg config.Fairness) Option {
+	return func(s *Service) {
+		s.fairness = cfg
+	}
+}
+
+// WithRandSource задаёт источник случайности для выбора ревьюверов.
+// С фиксированным seed последовательность назначений воспроизводима.
+func WithRandSource(src rand.Source) Option {
+	return func(s *Service) {
+		s.rnd = rand.New(src)
+	}
 }
 
-func New(log *slog.Logger, pr PullRequestRepo, team TeamRepo, user UserRepo) *Service {
-	return &Service{
-		log:  log,
-		pr:   pr,
-		team: team,
-		user: user,
+func New(log *slog.Logger, pr PullRequestRepo, team TeamRepo, user UserRepo, owners CodeOwnersRepo, hooks WebhookRepo, opts ...Option) *Service {
+	s := &Service{
+		log:    log,
+		pr:     pr,
+		team:   team,
+		user:   user,
+		owners: owners,
+		hooks:  hooks,
+		picker: &RandomPicker{},
+		cfg: config.PR{
+			MaxReviewers:     2,
+			AssignOnlyActive: true,
+			Merge: config.MergeRules{
+				BlockOnChangesRequested: true,
+			},
+		},
+		fairness: config.Fairness{
+			GiniThreshold: 0.3,
+			Tolerance:     0.5,
+		},
+		rnd: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
-func (s *Service) PullRequestCreate(ctx context.Context, prId, prName, authorId string) (*domain.PullRequest, error) {
-	author, err := s.user.GetUserById(ctx, authorId)
+// PullRequestCreate создаёт PR. Черновику (draft) ревьюверы не назначаются до PullRequestReady.
+// Владельцы изменённых путей (CODEOWNERS) назначаются в первую очередь.
+func (s *Service) PullRequestCreate(ctx context.Context, params domain.PullRequestCreateParams) (*domain.PullRequest, error) {
+	author, err := s.user.GetUserById(ctx, params.AuthorID)
 	if err != nil {
-		s.log.Error("service.PullRequestCreate: failed to get author by ID", slog.String("author_id", authorId), slog.Any("error", err))
+		s.log.Error("service.PullRequestCreate: failed to get author by ID", slog.String("author_id", params.AuthorID), slog.Any("error", err))
 		return nil, err
 	}
 
-	authorTeam, err := s.team.GetTeam(ctx, author.TeamName)
+	createdAt := time.Now()
+	pr := &domain.PullRequest{
+		PullRequestId:   params.ID,
+		PullRequestName: params.Name,
+		AuthorId:        params.AuthorID,
+		Status:          domain.PRStatusDraft,
+		ChangedPaths:    params.ChangedPaths,
+		CreatedAt:       &createdAt,
+	}
+
+	requiredTags, err := normalizeTags(params.RequiredTags)
 	if err != nil {
-		s.log.Error("service.PullRequestCreate: failed to get author team", slog.String("team_name", author.TeamName), slog.Any("error", err))
 		return nil, err
 	}
+	pr.RequiredTags = requiredTags
 
-	if len(authorTeam.Members) == 0 {
-		return nil, domain.ErrNoCandidate
+	if !params.Draft {
+		reviewers, err := s.assignReviewers(ctx, pr, author)
+		if err != nil {
+			s.log.Error("service.PullRequestCreate: failed to assign reviewers", slog.String("pr_id", params.ID), slog.Any("error", err))
+			return nil, err
+		}
+		pr.Status = domain.PRStatusOpen
+		pr.AssignedReviewers = reviewers
 	}
 
-	candidates := []domain.User{}
-	for _, m := range authorTeam.Members {
-		if m.ID != authorId && m.IsActive {
-			candidates = append(candidates, m)
-		}
+	created, err := s.pr.Create(ctx, pr, domain.AssignmentAudit{
+		ActorID:  params.AuthorID,
+		Reason:   domain.AssignmentReasonCreated,
+		Strategy: s.strategyName(),
+	})
+	if err != nil {
+		s.log.Error("service.PullRequestCreate: failed to create PR in repo", slog.String("pr_id", params.ID), slog.Any("error", err))
+		return nil, err
 	}
+	return created, nil
+}
 
-	reviewers := reviewersIds(pickRandomUsers(candidates, 2))
-	createdAt := time.Now()
+// PullRequestReady переводит черновик в OPEN и назначает ревьюверов
+func (s *Service) PullRequestReady(ctx context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	return s.openPR(ctx, "service.PullRequestReady", prId, actorId, domain.PRStatusDraft, domain.PRHistoryReady)
+}
+
+// PullRequestReopen возвращает закрытый PR в OPEN с новым набором ревьюверов
+func (s *Service) PullRequestReopen(ctx context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	return s.openPR(ctx, "service.PullRequestReopen", prId, actorId, domain.PRStatusClosed, domain.PRHistoryReopened)
+}
 
-	pr, err := s.pr.Create(ctx, prId, prName, authorId, reviewers, createdAt)
+// PullRequestClose закрывает PR без merge и снимает с него всех ревьюверов. Операция идемпотентна.
+func (s *Service) PullRequestClose(ctx context.Context, prId, actorId string) (*domain.PullRequest, error) {
+	pr, err := s.pr.GetPR(ctx, prId)
 	if err != nil {
-		s.log.Error("service.PullRequestCreate: failed to create PR in repo", slog.String("pr_id", prId), slog.Any("error", err))
+		s.log.Error("service.PullRequestClose: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
 		return nil, err
 	}
-	return pr, nil
+
+	if pr.Status == domain.PRStatusClosed {
+		return pr, nil
+	}
+	if !canTransition(pr.Status, domain.PRStatusClosed) {
+		return nil, domain.ErrInvalidTransition
+	}
+
+	entry := domain.PRHistoryEntry{
+		PullRequestID: prId,
+		Event:         domain.PRHistoryClosed,
+		ActorID:       actorId,
+		Details:       fmt.Sprintf("released reviewers: %v", pr.AssignedReviewers),
+		CreatedAt:     time.Now().In(time.UTC),
+	}
+
+	pr.Status = domain.PRStatusClosed
+	pr.AssignedReviewers = nil
+
+	audit := domain.AssignmentAudit{ActorID: actorId, Reason: string(domain.PRHistoryClosed)}
+	updatedPR, err := s.pr.UpdatePR(ctx, pr, audit, entry)
+	if err != nil {
+		s.log.Error("service.PullRequestClose: failed to update PR in repo", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+	return updatedPR, nil
+}
+
+func (s *Service) openPR(ctx context.Context, op, prId, actorId string, from domain.PullRequestStatus, event domain.PRHistoryEvent) (*domain.PullRequest, error) {
+	pr, err := s.pr.GetPR(ctx, prId)
+	if err != nil {
+		s.log.Error(op+": failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+
+	if pr.Status != from || !canTransition(pr.Status, domain.PRStatusOpen) {
+		return nil, domain.ErrInvalidTransition
+	}
+
+	author, err := s.user.GetUserById(ctx, pr.AuthorId)
+	if err != nil {
+		s.log.Error(op+": failed to get author by ID", slog.String("author_id", pr.AuthorId), slog.Any("error", err))
+		return nil, err
+	}
+
+	reviewers, err := s.assignReviewers(ctx, pr, author)
+	if err != nil {
+		s.log.Error(op+": failed to assign reviewers", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+
+	pr.Status = domain.PRStatusOpen
+	pr.AssignedReviewers = reviewers
+
+	audit := domain.AssignmentAudit{ActorID: actorId, Reason: string(event), Strategy: s.strategyName()}
+	updatedPR, err := s.pr.UpdatePR(ctx, pr, audit, domain.PRHistoryEntry{
+		PullRequestID: prId,
+		Event:         event,
+		ActorID:       actorId,
+		CreatedAt:     time.Now().In(time.UTC),
+	})
+	if err != nil {
+		s.log.Error(op+": failed to update PR in repo", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+	return updatedPR, nil
 }
 
-func (s *Service) PullRequestMerge(ctx context.Context, prId string) (*domain.PullRequest, error) {
+func (s *Service) PullRequestMerge(ctx context.Context, prId string, opts domain.MergeOptions) (*domain.PullRequest, error) {
 	pr, err := s.pr.GetPR(ctx, prId)
 	if err != nil {
 		s.log.Error("service.PullRequestMerge: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
@@ -93,18 +285,77 @@ func (s *Service) PullRequestMerge(ctx context.Context, prId string) (*domain.Pu
 	if pr.Status == domain.PRStatusMerged {
 		return pr, nil
 	}
+	if !canTransition(pr.Status, domain.PRStatusMerged) {
+		return nil, domain.ErrInvalidTransition
+	}
+
+	entry := domain.PRHistoryEntry{
+		PullRequestID: prId,
+		Event:         domain.PRHistoryMerged,
+		ActorID:       opts.ActorID,
+		CreatedAt:     time.Now().In(time.UTC),
+	}
+
+	if opts.Force {
+		// actor_id приходит из тела запроса и не аутентифицирован: список admins защищает
+		// от случайного force, но не от подмены — это не граница безопасности
+		if !slices.Contains(s.cfg.Merge.Admins, opts.ActorID) {
+			return nil, domain.ErrForbidden
+		}
+		entry.Event = domain.PRHistoryForceMerged
+		entry.Details = opts.Reason
+		s.log.Warn("service.PullRequestMerge: force merge", slog.String("pr_id", prId), slog.String("actor_id", opts.ActorID), slog.String("reason", opts.Reason))
+	} else if err := s.checkMergeRules(pr); err != nil {
+		return nil, err
+	}
+
+	return s.markMerged(ctx, pr, entry)
+}
+
+// PullRequestRecordExternalMerge фиксирует merge, который уже произошёл на git-хостинге.
+// Это факт, а не запрос: правила одобрения и переходы статусов не проверяются.
+func (s *Service) PullRequestRecordExternalMerge(ctx context.Context, prId, actorId, source string) (*domain.PullRequest, error) {
+	pr, err := s.pr.GetPR(ctx, prId)
+	if err != nil {
+		s.log.Error("service.PullRequestRecordExternalMerge: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+
+	if pr.Status == domain.PRStatusMerged {
+		return pr, nil
+	}
+	if pr.Status != domain.PRStatusOpen {
+		s.log.Warn("service.PullRequestRecordExternalMerge: PR merged on host from non-open local state", slog.String("pr_id", prId), slog.String("status", string(pr.Status)))
+	}
 
+	return s.markMerged(ctx, pr, domain.PRHistoryEntry{
+		PullRequestID: prId,
+		Event:         domain.PRHistoryExternalMerged,
+		ActorID:       actorId,
+		Details:       source,
+		CreatedAt:     time.Now().In(time.UTC),
+	})
+}
+
+func (s *Service) markMerged(ctx context.Context, pr *domain.PullRequest, entry domain.PRHistoryEntry) (*domain.PullRequest, error) {
 	pr.Status = domain.PRStatusMerged
 
-	updatedPR, err := s.pr.UpdatePR(ctx, pr)
+	audit := domain.AssignmentAudit{ActorID: entry.ActorID, Reason: string(entry.Event)}
+	updatedPR, err := s.pr.UpdatePR(ctx, pr, audit, entry)
 	if err != nil {
-		s.log.Error("service.PullRequestMerge: failed to update PR status in repo", slog.String("pr_id", prId), slog.Any("error", err))
+		s.log.Error("service.markMerged: failed to update PR status in repo", slog.String("pr_id", pr.PullRequestId), slog.Any("error", err))
 		return nil, err
 	}
 	return updatedPR, nil
 }
 
-func (s *Service) PullRequestReassign(ctx context.Context, prId, oldUserId string) (*domain.PullRequest, string, error) {
+// PullRequestReassign заменяет oldUserId другим ревьювером; actorId попадает в журнал назначений
+func (s *Service) PullRequestReassign(ctx context.Context, prId, oldUserId, actorId string) (*domain.PullRequest, string, error) {
+	return s.reassign(ctx, prId, oldUserId, actorId, domain.AssignmentReasonManual)
+}
+
+// reassign заменяет ревьювера oldUserId новым кандидатом и пишет reason в журнал назначений
+func (s *Service) reassign(ctx context.Context, prId, oldUserId, actorId, reason string) (*domain.PullRequest, string, error) {
 	pr, err := s.pr.GetPR(ctx, prId)
 	if err != nil {
 		s.log.Error("service.PullRequestReassign: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
@@ -132,33 +383,42 @@ func (s *Service) PullRequestReassign(ctx context.Context, prId, oldUserId strin
 		return nil, "", err
 	}
 
-	oldUserTeam, err := s.team.GetTeam(ctx, oldUser.TeamName)
+	policy, err := s.teamPolicy(ctx, oldUser.TeamName)
 	if err != nil {
-		s.log.Error("service.PullRequestReassign: failed to get team for old user", slog.String("team_name", oldUser.TeamName), slog.Any("error", err))
+		s.log.Error("service.PullRequestReassign: failed to get team policy", slog.String("team_name", oldUser.TeamName), slog.Any("error", err))
 		return nil, "", err
 	}
 
-	candidates := make([]domain.User, 0)
-	assignedMap := make(map[string]bool, len(pr.AssignedReviewers))
+	exclude := map[string]bool{oldUserId: true, pr.AuthorId: true}
 	for _, uid := range pr.AssignedReviewers {
-		assignedMap[uid] = true
+		exclude[uid] = true
 	}
 
-	for _, m := range oldUserTeam.Members {
-		if m.ID == oldUserId || m.ID == pr.AuthorId || !m.IsActive || assignedMap[m.ID] {
-			continue
-		}
-		candidates = append(candidates, m)
+	remaining := slices.DeleteFunc(slices.Clone(pr.AssignedReviewers), func(id string) bool { return id == oldUserId })
+	need, err := s.uncoveredTags(ctx, pr.RequiredTags, remaining)
+	if err != nil {
+		s.log.Error("service.PullRequestReassign: failed to get reviewers tags", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, "", err
 	}
 
-	if len(candidates) == 0 {
+	picked, saturated, err := s.pickReviewers(ctx, pr, policy, exclude, 1, need)
+	if err != nil {
+		s.log.Error("service.PullRequestReassign: failed to pick new reviewer", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, "", err
+	}
+	if len(picked) == 0 {
+		if saturated {
+			return nil, "", domain.ErrCapacityExhausted
+		}
 		return nil, "", domain.ErrNoCandidate
 	}
+	newReviewer := picked[0]
 
-	rand.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
-	newReviewer := candidates[0]
-
-	updatedPR, err := s.pr.Reassign(ctx, prId, oldUserId, newReviewer.ID)
+	updatedPR, err := s.pr.Reassign(ctx, prId, oldUserId, newReviewer.ID, domain.AssignmentAudit{
+		ActorID:  actorId,
+		Reason:   reason,
+		Strategy: s.strategyName(),
+	})
 	if err != nil {
 		s.log.Error("service.PullRequestReassign: failed to reassign PR in repo", slog.String("pr_id", prId), slog.String("old_user", oldUserId), slog.String("new_user", newReviewer.ID), slog.Any("error", err))
 		return nil, "", err
@@ -167,7 +427,54 @@ func (s *Service) PullRequestReassign(ctx context.Context, prId, oldUserId strin
 	return updatedPR, newReviewer.ID, nil
 }
 
+func (s *Service) PullRequestReview(ctx context.Context, prId, reviewerId string, state domain.ReviewState) (*domain.PullRequest, error) {
+	switch state {
+	case domain.ReviewStateApproved, domain.ReviewStateChangesRequested, domain.ReviewStateCommented:
+	default:
+		return nil, domain.ErrInvalidReviewState
+	}
+
+	pr, err := s.pr.GetPR(ctx, prId)
+	if err != nil {
+		s.log.Error("service.PullRequestReview: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+
+	// вердикт принимается только у открытого PR; у черновика и закрытого PR ревью не идёт
+	switch pr.Status {
+	case domain.PRStatusOpen:
+	case domain.PRStatusMerged:
+		return nil, domain.ErrPRMerged
+	default:
+		return nil, fmt.Errorf("%w: cannot review %s PR", domain.ErrInvalidTransition, pr.Status)
+	}
+
+	updatedPR, err := s.pr.SetReviewState(ctx, prId, reviewerId, state, time.Now().In(time.UTC))
+	if err != nil {
+		s.log.Error("service.PullRequestReview: failed to set review state in repo", slog.String("pr_id", prId), slog.String("reviewer_id", reviewerId), slog.Any("error", err))
+		return nil, err
+	}
+	return updatedPR, nil
+}
+
 func (s *Service) TeamAdd(ctx context.Context, teamName string, members []domain.User) (*domain.Team, error) {
+	for i := range members {
+		email, err := normalizeEmail(members[i].Email)
+		if err != nil {
+			return nil, err
+		}
+		members[i].Email = email
+
+		if members[i].Tags == nil {
+			continue
+		}
+		tags, err := normalizeTags(members[i].Tags)
+		if err != nil {
+			return nil, err
+		}
+		members[i].Tags = tags
+	}
+
 	team, err := s.team.Add(ctx, teamName, members)
 	if err != nil {
 		s.log.Error("service.TeamAdd: failed to add team in repo", slog.String("team_name", teamName), slog.Any("error", err))
@@ -185,23 +492,60 @@ func (s *Service) TeamGet(ctx context.Context, teamName string) (*domain.Team, e
 	return team, nil
 }
 
-func (s *Service) UsersGetReview(ctx context.Context, userId string) ([]*domain.PullRequest, error) {
-	PRs, err := s.pr.ListPRs(ctx)
+func (s *Service) TeamSetPolicy(ctx context.Context, policy domain.TeamPolicy) (*domain.TeamPolicy, error) {
+	if policy.MinReviewers < 0 || policy.MaxReviewers < policy.MinReviewers || policy.DefaultCapacity < 0 {
+		return nil, domain.ErrInvalidPolicy
+	}
+
+	if _, err := s.team.GetTeam(ctx, policy.TeamName); err != nil {
+		s.log.Error("service.TeamSetPolicy: failed to get team from repo", slog.String("team_name", policy.TeamName), slog.Any("error", err))
+		return nil, err
+	}
+
+	saved, err := s.team.SetPolicy(ctx, policy)
 	if err != nil {
-		s.log.Error("service.UsersGetReview: failed to list all PRs from repo", slog.Any("error", err))
+		s.log.Error("service.TeamSetPolicy: failed to save team policy in repo", slog.String("team_name", policy.TeamName), slog.Any("error", err))
 		return nil, err
 	}
+	return saved, nil
+}
 
-	res := []*domain.PullRequest{}
-	for _, pr := range PRs {
-		for _, ar := range pr.AssignedReviewers {
-			if ar == userId {
-				res = append(res, pr)
-			}
+func (s *Service) TeamSetFallbacks(ctx context.Context, teamName string, fallbacks []string) ([]string, error) {
+	seen := map[string]bool{teamName: true}
+	for _, fallback := range fallbacks {
+		if seen[fallback] {
+			return nil, domain.ErrInvalidFallback
+		}
+		seen[fallback] = true
+	}
+
+	for teamToCheck := range seen {
+		if _, err := s.team.GetTeam(ctx, teamToCheck); err != nil {
+			s.log.Error("service.TeamSetFallbacks: failed to get team from repo", slog.String("team_name", teamToCheck), slog.Any("error", err))
+			return nil, err
 		}
 	}
 
-	return res, nil
+	saved, err := s.team.SetFallbacks(ctx, teamName, fallbacks)
+	if err != nil {
+		s.log.Error("service.TeamSetFallbacks: failed to save fallback chain in repo", slog.String("team_name", teamName), slog.Any("error", err))
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (s *Service) TeamGetFallbacks(ctx context.Context, teamName string) ([]string, error) {
+	if _, err := s.team.GetTeam(ctx, teamName); err != nil {
+		s.log.Error("service.TeamGetFallbacks: failed to get team from repo", slog.String("team_name", teamName), slog.Any("error", err))
+		return nil, err
+	}
+
+	fallbacks, err := s.team.GetFallbacks(ctx, teamName)
+	if err != nil {
+		s.log.Error("service.TeamGetFallbacks: failed to get fallback chain from repo", slog.String("team_name", teamName), slog.Any("error", err))
+		return nil, err
+	}
+	return fallbacks, nil
 }
 
 func (s *Service) SetUserActive(ctx context.Context, userId string, isActive bool) (*domain.User, error) {
@@ -213,36 +557,118 @@ func (s *Service) SetUserActive(ctx context.Context, userId string, isActive boo
 	return user, nil
 }
 
-func (s *Service) GetAssignmentStats(ctx context.Context) ([]domain.AssignmentCountByUser, []domain.AssignmentCountByPR, error) {
-	prs, err := s.pr.ListPRs(ctx)
+// UserSetMaxOpenReviews задаёт личный лимит открытых ревью; nil возвращает пользователя к лимиту команды.
+// Лимит должен быть положительным: исключают из назначений через is_active или недоступность.
+func (s *Service) UserSetMaxOpenReviews(ctx context.Context, userId string, limit *int) (*domain.User, error) {
+	if limit != nil && *limit <= 0 {
+		return nil, domain.ErrInvalidCapacity
+	}
+
+	user, err := s.user.SetMaxOpenReviews(ctx, userId, limit)
 	if err != nil {
-		s.log.Error("service.GetAssignmentStats: failed to list all PRs from repo", slog.Any("error", err))
-		return nil, nil, err
+		s.log.Error("service.UserSetMaxOpenReviews: failed to update user in repo", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
 	}
+	return user, nil
+}
 
-	userCounts := make(map[string]int)
-	prCounts := make(map[string]int)
+// UserAddUnavailability добавляет период недоступности. Если период уже начался и reassignOpen,
+// открытые ревью пользователя сразу переназначаются; возвращается число переназначенных PR.
+func (s *Service) UserAddUnavailability(ctx context.Context, period domain.Unavailability, reassignOpen bool) (*domain.Unavailability, int, error) {
+	if !period.EndsAt.After(period.StartsAt) {
+		return nil, 0, domain.ErrInvalidPeriod
+	}
 
-	for _, pr := range prs {
-		count := len(pr.AssignedReviewers)
-		prCounts[pr.PullRequestId] = count
+	if _, err := s.user.GetUserById(ctx, period.UserID); err != nil {
+		s.log.Error("service.UserAddUnavailability: failed to get user by ID", slog.String("user_id", period.UserID), slog.Any("error", err))
+		return nil, 0, err
+	}
 
-		for _, reviewerID := range pr.AssignedReviewers {
-			userCounts[reviewerID]++
-		}
+	saved, err := s.user.AddUnavailability(ctx, period)
+	if err != nil {
+		s.log.Error("service.UserAddUnavailability: failed to save period in repo", slog.String("user_id", period.UserID), slog.Any("error", err))
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	if !reassignOpen || saved.StartsAt.After(now) || !saved.EndsAt.After(now) {
+		return saved, 0, nil
+	}
+
+	_, reassignedCount, failedCount, err := s.replaceReviewers(ctx, "service.UserAddUnavailability", domain.AssignmentReasonUnavailable, []string{saved.UserID})
+	if err != nil {
+		return nil, 0, err
+	}
+	if failedCount > 0 {
+		s.log.Warn("service.UserAddUnavailability: some open reviews were not reassigned", slog.String("user_id", saved.UserID), slog.Int("failed", failedCount))
+	}
+
+	return saved, reassignedCount, nil
+}
+
+func (s *Service) UserUpdateUnavailability(ctx context.Context, period domain.Unavailability) (*domain.Unavailability, error) {
+	if !period.EndsAt.After(period.StartsAt) {
+		return nil, domain.ErrInvalidPeriod
+	}
+
+	saved, err := s.user.UpdateUnavailability(ctx, period)
+	if err != nil {
+		s.log.Error("service.UserUpdateUnavailability: failed to update period in repo", slog.Int64("id", period.ID), slog.Any("error", err))
+		return nil, err
 	}
+	return saved, nil
+}
 
-	byUser := make([]domain.AssignmentCountByUser, 0, len(userCounts))
-	for id, count := range userCounts {
-		byUser = append(byUser, domain.AssignmentCountByUser{UserID: id, AssignmentsCount: count})
+func (s *Service) UserDeleteUnavailability(ctx context.Context, id int64) error {
+	if err := s.user.DeleteUnavailability(ctx, id); err != nil {
+		s.log.Error("service.UserDeleteUnavailability: failed to delete period in repo", slog.Int64("id", id), slog.Any("error", err))
+		return err
 	}
+	return nil
+}
 
-	byPR := make([]domain.AssignmentCountByPR, 0, len(prCounts))
-	for id, count := range prCounts {
-		byPR = append(byPR, domain.AssignmentCountByPR{PullRequestID: id, ReviewersCount: count})
+func (s *Service) UserListUnavailability(ctx context.Context, userId string) ([]domain.Unavailability, error) {
+	if _, err := s.user.GetUserById(ctx, userId); err != nil {
+		s.log.Error("service.UserListUnavailability: failed to get user by ID", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
 	}
 
-	return byUser, byPR, nil
+	periods, err := s.user.ListUnavailability(ctx, userId)
+	if err != nil {
+		s.log.Error("service.UserListUnavailability: failed to list periods from repo", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
+	}
+	return periods, nil
+}
+
+// PullRequestHistory возвращает журнал назначений ревьюверов PR
+func (s *Service) PullRequestHistory(ctx context.Context, prId string) ([]domain.AssignmentEvent, error) {
+	if _, err := s.pr.GetPR(ctx, prId); err != nil {
+		s.log.Error("service.PullRequestHistory: failed to get PR by ID", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+
+	events, err := s.pr.ListAssignmentEvents(ctx, prId)
+	if err != nil {
+		s.log.Error("service.PullRequestHistory: failed to list assignment events", slog.String("pr_id", prId), slog.Any("error", err))
+		return nil, err
+	}
+	return events, nil
+}
+
+// UserHistory возвращает события, в которых пользователь был назначен ревьювером или снят
+func (s *Service) UserHistory(ctx context.Context, userId string) ([]domain.AssignmentEvent, error) {
+	if _, err := s.user.GetUserById(ctx, userId); err != nil {
+		s.log.Error("service.UserHistory: failed to get user by ID", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
+	}
+
+	events, err := s.pr.ListAssignmentEventsByUser(ctx, userId)
+	if err != nil {
+		s.log.Error("service.UserHistory: failed to list assignment events", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
+	}
+	return events, nil
 }
 
 func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]string, []*domain.PullRequest, int, int, error) {
@@ -259,10 +685,21 @@ func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]s
 		return []string{}, nil, 0, 0, nil
 	}
 
-	openPRs, err := s.pr.ListOpenPRsByReviewers(ctx, deactivatedUserIDs)
+	updatedPRs, reassignedCount, failedCount, err := s.replaceReviewers(ctx, "service.TeamDeactivateUsers", domain.AssignmentReasonTeamDeactivated, deactivatedUserIDs)
 	if err != nil {
-		s.log.Error("service.TeamDeactivateUsers: failed to list open PRs by reviewers", slog.Any("deactivated_users", deactivatedUserIDs), slog.Any("error", err))
-		return deactivatedUserIDs, nil, 0, 0, fmt.Errorf("failed to list open PRs: %w", err)
+		return deactivatedUserIDs, nil, 0, 0, err
+	}
+
+	return deactivatedUserIDs, updatedPRs, reassignedCount, failedCount, nil
+}
+
+// replaceReviewers снимает userIDs со всех открытых PR и подбирает им замену.
+// Возвращает обновлённые PR, число успешно и неуспешно обработанных PR.
+func (s *Service) replaceReviewers(ctx context.Context, op, reason string, userIDs []string) ([]*domain.PullRequest, int, int, error) {
+	openPRs, err := s.pr.ListOpenPRsByReviewers(ctx, userIDs)
+	if err != nil {
+		s.log.Error(op+": failed to list open PRs by reviewers", slog.Any("replaced_users", userIDs), slog.Any("error", err))
+		return nil, 0, 0, fmt.Errorf("failed to list open PRs: %w", err)
 	}
 
 	updatedPRs := make([]*domain.PullRequest, 0)
@@ -274,15 +711,9 @@ func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]s
 		reviewersToReplaceCount := 0
 
 		for _, reviewerID := range pr.AssignedReviewers {
-			isDeactivated := false
-			for _, deactivatedID := range deactivatedUserIDs {
-				if reviewerID == deactivatedID {
-					isDeactivated = true
-					reviewersToReplaceCount++
-					break
-				}
-			}
-			if !isDeactivated {
+			if slices.Contains(userIDs, reviewerID) {
+				reviewersToReplaceCount++
+			} else {
 				currentActiveReviewers = append(currentActiveReviewers, reviewerID)
 			}
 		}
@@ -293,34 +724,42 @@ func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]s
 
 		author, err := s.user.GetUserById(ctx, pr.AuthorId)
 		if err != nil {
-			s.log.Error("service.TeamDeactivateUsers: failed to get author for reassignment logic (skipping PR)", slog.String("pr_id", pr.PullRequestId), slog.String("author_id", pr.AuthorId), slog.Any("error", err))
+			s.log.Error(op+": failed to get author for reassignment logic (skipping PR)", slog.String("pr_id", pr.PullRequestId), slog.String("author_id", pr.AuthorId), slog.Any("error", err))
 			failedCount++
 			continue
 		}
 
-		activeCandidates, err := s.user.ListActiveMembersByTeam(ctx, author.TeamName, pr.AuthorId)
+		policy, err := s.teamPolicy(ctx, author.TeamName)
 		if err != nil {
-			s.log.Error("service.TeamDeactivateUsers: failed to list active members for replacement (skipping PR)", slog.String("team_name", author.TeamName), slog.Any("error", err))
+			s.log.Error(op+": failed to get team policy (skipping PR)", slog.String("team_name", author.TeamName), slog.Any("error", err))
 			failedCount++
 			continue
 		}
 
-		candidates := make([]domain.User, 0)
-		assignedMap := make(map[string]bool)
+		exclude := map[string]bool{pr.AuthorId: true}
 		for _, id := range pr.AssignedReviewers {
-			assignedMap[id] = true
+			exclude[id] = true
+		}
+		for _, id := range userIDs {
+			exclude[id] = true
 		}
 
-		for _, m := range activeCandidates {
-			if !assignedMap[m.ID] {
-				candidates = append(candidates, m)
-			}
+		need, err := s.uncoveredTags(ctx, pr.RequiredTags, currentActiveReviewers)
+		if err != nil {
+			s.log.Error(op+": failed to get reviewers tags (skipping PR)", slog.String("pr_id", pr.PullRequestId), slog.Any("error", err))
+			failedCount++
+			continue
 		}
 
-		newReviewers := pickRandomUsers(candidates, reviewersToReplaceCount)
+		newReviewers, _, err := s.pickReviewers(ctx, pr, policy, exclude, reviewersToReplaceCount, need)
+		if err != nil {
+			s.log.Error(op+": failed to pick replacement reviewers (skipping PR)", slog.String("pr_id", pr.PullRequestId), slog.Any("error", err))
+			failedCount++
+			continue
+		}
 
 		if len(newReviewers) < reviewersToReplaceCount {
-			s.log.Warn("service.TeamDeactivateUsers: not enough replacement candidates found", slog.String("pr_id", pr.PullRequestId), slog.Int("needed", reviewersToReplaceCount), slog.Int("available", len(candidates)))
+			s.log.Warn(op+": not enough replacement candidates found", slog.String("pr_id", pr.PullRequestId), slog.Int("needed", reviewersToReplaceCount), slog.Int("available", len(newReviewers)))
 			failedCount++
 			continue
 		}
@@ -331,9 +770,9 @@ func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]s
 		}
 
 		pr.AssignedReviewers = finalReviewers
-		updatedPR, err := s.pr.UpdatePR(ctx, pr)
+		updatedPR, err := s.pr.UpdatePR(ctx, pr, domain.AssignmentAudit{Reason: reason, Strategy: s.strategyName()})
 		if err != nil {
-			s.log.Error("service.TeamDeactivateUsers: failed to update PR after reassignment", slog.String("pr_id", pr.PullRequestId), slog.Any("error", err))
+			s.log.Error(op+": failed to update PR after reassignment", slog.String("pr_id", pr.PullRequestId), slog.Any("error", err))
 			failedCount++
 		} else {
 			updatedPRs = append(updatedPRs, updatedPR)
@@ -341,20 +780,252 @@ func (s *Service) TeamDeactivateUsers(ctx context.Context, teamName string) ([]s
 		}
 	}
 
-	return deactivatedUserIDs, updatedPRs, reassignedCount, failedCount, nil
+	return updatedPRs, reassignedCount, failedCount, nil
 }
 
-func pickRandomUsers(users []domain.User, count int) []domain.User {
-	if len(users) == 0 {
-		return nil
+// assignReviewers подбирает стартовый набор ревьюверов по политике команды автора
+func (s *Service) assignReviewers(ctx context.Context, pr *domain.PullRequest, author *domain.User) ([]string, error) {
+	prId := pr.PullRequestId
+	policy, err := s.teamPolicy(ctx, author.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team policy: %w", err)
+	}
+
+	exclude := map[string]bool{author.ID: true}
+	picked, saturated, err := s.pickReviewers(ctx, pr, policy, exclude, policy.MaxReviewers, pr.RequiredTags)
+	if err != nil {
+		return nil, err
 	}
 
-	rand.Shuffle(len(users), func(i, j int) { users[i], users[j] = users[j], users[i] })
+	if saturated && (len(picked) == 0 || len(picked) < policy.MinReviewers) {
+		s.log.Warn("service.assignReviewers: all candidates reached review capacity", slog.String("pr_id", prId), slog.Int("available", len(picked)))
+		return nil, domain.ErrCapacityExhausted
+	}
 
-	if len(users) < count {
-		return users
+	if len(picked) < policy.MinReviewers {
+		s.log.Warn("service.assignReviewers: not enough reviewers for team policy", slog.String("pr_id", prId), slog.Int("required", policy.MinReviewers), slog.Int("available", len(picked)))
+		return nil, domain.ErrNoCandidate
 	}
-	return users[:count]
+
+	return reviewersIds(picked), nil
+}
+
+// допустимые переходы статусов PR
+var prTransitions = map[domain.PullRequestStatus][]domain.PullRequestStatus{
+	domain.PRStatusDraft:  {domain.PRStatusOpen, domain.PRStatusClosed},
+	domain.PRStatusOpen:   {domain.PRStatusMerged, domain.PRStatusClosed},
+	domain.PRStatusClosed: {domain.PRStatusOpen},
+}
+
+func canTransition(from, to domain.PullRequestStatus) bool {
+	return slices.Contains(prTransitions[from], to)
+}
+
+// checkMergeRules проверяет, что у PR достаточно одобрений и нет открытых CHANGES_REQUESTED.
+// Если ревьюверов меньше, чем нужно одобрений (некого было назначить или всех сняли), merge запрещён.
+func (s *Service) checkMergeRules(pr *domain.PullRequest) error {
+	approvals := 0
+	for _, r := range pr.Reviewers {
+		switch r.State {
+		case domain.ReviewStateApproved:
+			approvals++
+		case domain.ReviewStateChangesRequested:
+			if s.cfg.Merge.BlockOnChangesRequested {
+				return fmt.Errorf("%w: reviewer %s requested changes", domain.ErrNotApproved, r.UserID)
+			}
+		}
+	}
+
+	required := s.cfg.Merge.RequiredApprovals
+	if len(pr.Reviewers) < required {
+		return fmt.Errorf("%w: %d reviewers assigned, %d approvals required", domain.ErrNotApproved, len(pr.Reviewers), required)
+	}
+	if approvals < required {
+		return fmt.Errorf("%w: %d of %d approvals", domain.ErrNotApproved, approvals, required)
+	}
+	return nil
+}
+
+// teamPolicy возвращает политику команды, а при её отсутствии — значения из конфига
+func (s *Service) teamPolicy(ctx context.Context, teamName string) (domain.TeamPolicy, error) {
+	policy, err := s.team.GetPolicy(ctx, teamName)
+	if errors.Is(err, domain.ErrPolicyNotFound) {
+		return domain.TeamPolicy{
+			TeamName:         teamName,
+			MaxReviewers:     s.cfg.MaxReviewers,
+			AssignOnlyActive: s.cfg.AssignOnlyActive,
+			DefaultCapacity:  s.cfg.DefaultCapacity,
+		}, nil
+	}
+	if err != nil {
+		return domain.TeamPolicy{}, err
+	}
+	return *policy, nil
+}
+
+// pickReviewers выбирает до count ревьюверов: сначала закрывает требуемые навыки need, затем
+// берёт владельцев изменённых путей и, наконец, команду политики и по порядку её резервные команды.
+// Выбранные добавляются в exclude. Также сообщает, были ли отброшены кандидаты,
+// исчерпавшие лимит открытых ревью.
+func (s *Service) pickReviewers(ctx context.Context, pr *domain.PullRequest, policy domain.TeamPolicy, exclude map[string]bool, count int, need []string) ([]domain.User, bool, error) {
+	picked, saturated, err := s.coverTags(ctx, pr, policy, exclude, need, count)
+	if err != nil {
+		return nil, saturated, err
+	}
+
+	owners, dropped, err := s.pickOwners(ctx, pr, policy, exclude, count-len(picked))
+	if err != nil {
+		return nil, false, err
+	}
+	saturated = saturated || dropped
+	picked = append(picked, owners...)
+
+	chain, err := s.teamChain(ctx, policy)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, teamName := range chain {
+		if len(picked) >= count {
+			break
+		}
+
+		candidates, dropped, err := s.teamPool(ctx, policy, teamName, exclude)
+		if err != nil {
+			return nil, false, err
+		}
+		saturated = saturated || dropped
+
+		users, err := s.picker.Pick(ctx, s.pickRand(pr.PullRequestId, candidates), teamPoolKey(teamName), candidates, count-len(picked))
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, u := range users {
+			exclude[u.ID] = true
+		}
+		picked = append(picked, users...)
+	}
+
+	return picked, saturated, nil
+}
+
+// teamChain возвращает команду политики и её резервные команды в порядке перебора
+func (s *Service) teamChain(ctx context.Context, policy domain.TeamPolicy) ([]string, error) {
+	fallbacks, err := s.team.GetFallbacks(ctx, policy.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fallback teams: %w", err)
+	}
+	return append([]string{policy.TeamName}, fallbacks...), nil
+}
+
+// teamPool возвращает кандидатов команды без исключённых и без тех, кто исчерпал лимит ревью
+func (s *Service) teamPool(ctx context.Context, policy domain.TeamPolicy, teamName string, exclude map[string]bool) ([]domain.User, bool, error) {
+	members, err := s.teamCandidates(ctx, teamName, policy.AssignOnlyActive)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list candidates of team %s: %w", teamName, err)
+	}
+
+	candidates := make([]domain.User, 0, len(members))
+	for _, m := range members {
+		if !exclude[m.ID] {
+			candidates = append(candidates, m)
+		}
+	}
+
+	teamDefault, err := s.teamCapacity(ctx, policy, teamName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return s.withinCapacity(ctx, candidates, teamDefault)
+}
+
+// teamCapacity возвращает лимит открытых ревью по умолчанию для участников teamName
+func (s *Service) teamCapacity(ctx context.Context, policy domain.TeamPolicy, teamName string) (int, error) {
+	if teamName == policy.TeamName {
+		return policy.DefaultCapacity, nil
+	}
+
+	teamPolicy, err := s.teamPolicy(ctx, teamName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get policy of team %s: %w", teamName, err)
+	}
+	return teamPolicy.DefaultCapacity, nil
+}
+
+// withinCapacity отбрасывает кандидатов, у которых открытых ревью не меньше лимита.
+// Лимит пользователя берётся из MaxOpenReviews (всегда положительный), иначе — teamDefault (0 — без ограничения).
+func (s *Service) withinCapacity(ctx context.Context, candidates []domain.User, teamDefault int) ([]domain.User, bool, error) {
+	limited := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if c.MaxOpenReviews != nil || teamDefault > 0 {
+			limited = append(limited, c.ID)
+		}
+	}
+	if len(limited) == 0 {
+		return candidates, false, nil
+	}
+
+	loads, err := s.pr.CountOpenReviews(ctx, limited)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to count open reviews: %w", err)
+	}
+
+	res := make([]domain.User, 0, len(candidates))
+	dropped := false
+	for _, c := range candidates {
+		limit := teamDefault
+		if c.MaxOpenReviews != nil {
+			limit = *c.MaxOpenReviews
+		} else if limit == 0 {
+			res = append(res, c)
+			continue
+		}
+
+		if loads[c.ID] >= limit {
+			dropped = true
+			continue
+		}
+		res = append(res, c)
+	}
+
+	return res, dropped, nil
+}
+
+// teamCandidates возвращает участников команды, не находящихся в отпуске в момент назначения
+func (s *Service) teamCandidates(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error) {
+	if onlyActive {
+		return s.user.ListActiveMembersByTeam(ctx, teamName, "", time.Now())
+	}
+	return s.user.ListAvailableMembersByTeam(ctx, teamName, "", time.Now())
+}
+
+// strategyName возвращает имя стратегии выбора для журнала назначений
+func (s *Service) strategyName() string {
+	if s.cfg.Strategy == "" {
+		return StrategyRandom
+	}
+	return s.cfg.Strategy
+}
+
+// pickRand возвращает источник случайности для одного выбора ревьюверов.
+// В режиме hash_pick он выводится из pull_request_id и списка кандидатов,
+// поэтому повтор с теми же входными данными даёт тех же ревьюверов.
+func (s *Service) pickRand(prId string, candidates []domain.User) *rand.Rand {
+	if s.cfg.HashPick {
+		h := fnv.New64a()
+		h.Write([]byte(prId))
+		for _, u := range sortedByID(candidates) {
+			h.Write([]byte{0})
+			h.Write([]byte(u.ID))
+		}
+		return rand.New(rand.NewSource(int64(h.Sum64())))
+	}
+
+	s.rndMu.Lock()
+	defer s.rndMu.Unlock()
+	return rand.New(rand.NewSource(s.rnd.Int63()))
 }
 
 func reviewersIds(users []domain.User) []string {
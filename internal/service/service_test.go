@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/config"
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+type fakePRRepo struct {
+	PullRequestRepo
+	loads map[string]int
+}
+
+func (f *fakePRRepo) Create(_ context.Context, pr *domain.PullRequest, _ domain.AssignmentAudit) (*domain.PullRequest, error) {
+	return pr, nil
+}
+
+func (f *fakePRRepo) CountOpenReviews(_ context.Context, userIDs []string) (map[string]int, error) {
+	return fakeLoads(f.loads).CountOpenReviews(context.Background(), userIDs)
+}
+
+type fakeTeamRepo struct {
+	TeamRepo
+}
+
+func (fakeTeamRepo) GetPolicy(context.Context, string) (*domain.TeamPolicy, error) {
+	return nil, domain.ErrPolicyNotFound
+}
+
+func (fakeTeamRepo) GetFallbacks(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+type fakeUserRepo struct {
+	UserRepo
+	members []domain.User
+}
+
+func (f *fakeUserRepo) GetUserById(_ context.Context, userId string) (*domain.User, error) {
+	for _, u := range f.members {
+		if u.ID == userId {
+			return &u, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (f *fakeUserRepo) ListActiveMembersByTeam(_ context.Context, teamName, _ string, _ time.Time) ([]domain.User, error) {
+	res := make([]domain.User, 0, len(f.members))
+	for _, u := range f.members {
+		if u.TeamName == teamName {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+func newTestService(t *testing.T, cfg config.PR, members []domain.User, loads map[string]int, opts ...Option) *Service {
+	t.Helper()
+	pr := &fakePRRepo{loads: loads}
+	picker, err := NewReviewerPicker(cfg.Strategy, cfg.Weights, pr)
+	if err != nil {
+		t.Fatalf("NewReviewerPicker: %v", err)
+	}
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	opts = append([]Option{WithConfig(cfg), WithPicker(picker)}, opts...)
+	return New(log, pr, fakeTeamRepo{}, &fakeUserRepo{members: members}, nil, nil, opts...)
+}
+
+func createPR(t *testing.T, s *Service, prId string) []string {
+	t.Helper()
+	pr, err := s.PullRequestCreate(context.Background(), domain.PullRequestCreateParams{ID: prId, Name: prId, AuthorID: "u1"})
+	if err != nil {
+		t.Fatalf("PullRequestCreate(%s): %v", prId, err)
+	}
+	return pr.AssignedReviewers
+}
+
+func TestPullRequestCreateSeededStrategies(t *testing.T) {
+	members := users("backend", "u1", "u2", "u3", "u4", "u5", "u6")
+	prIds := []string{"pr-1", "pr-2", "pr-3"}
+
+	tests := []struct {
+		name  string
+		cfg   config.PR
+		loads map[string]int
+		want  [][]string
+	}{
+		{
+			name: "random",
+			cfg:  config.PR{Strategy: StrategyRandom},
+			want: [][]string{{"u4", "u2"}, {"u6", "u2"}, {"u2", "u4"}},
+		},
+		{
+			name: "round robin",
+			cfg:  config.PR{Strategy: StrategyRoundRobin},
+			want: [][]string{{"u2", "u3"}, {"u4", "u5"}, {"u6", "u2"}},
+		},
+		{
+			// у u2, u3 и u6 по нулю открытых ревью, между ними решает rand
+			name:  "least loaded",
+			cfg:   config.PR{Strategy: StrategyLeastLoaded},
+			loads: map[string]int{"u4": 1, "u5": 5},
+			want:  [][]string{{"u2", "u3"}, {"u6", "u2"}, {"u2", "u6"}},
+		},
+		{
+			// u3 и u4 с нулевым и отрицательным весом не выбираются, пока есть другие кандидаты
+			name: "weighted",
+			cfg:  config.PR{Strategy: StrategyWeighted, Weights: map[string]int{"u2": 5, "u3": 0, "u4": -1}},
+			want: [][]string{{"u2", "u6"}, {"u5", "u6"}, {"u2", "u5"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.MaxReviewers = 2
+			tt.cfg.AssignOnlyActive = true
+
+			// два сервиса с одним seed дают одинаковую последовательность назначений
+			for range 2 {
+				s := newTestService(t, tt.cfg, members, tt.loads, WithRandSource(rand.NewSource(42)))
+				got := make([][]string, 0, len(prIds))
+				for _, prId := range prIds {
+					got = append(got, createPR(t, s, prId))
+				}
+				if !slices.EqualFunc(got, tt.want, slices.Equal) {
+					t.Errorf("reviewers = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestCreateHashPick(t *testing.T) {
+	members := users("backend", "u1", "u2", "u3", "u4", "u5", "u6")
+	shuffled := users("backend", "u5", "u2", "u6", "u1", "u4", "u3")
+
+	tests := []struct {
+		strategy string
+		want     map[string][]string
+	}{
+		{StrategyRandom, map[string][]string{"pr-1": {"u3", "u6"}, "pr-2": {"u5", "u2"}, "pr-3": {"u6", "u4"}}},
+		{StrategyLeastLoaded, map[string][]string{"pr-1": {"u3", "u6"}, "pr-2": {"u5", "u2"}, "pr-3": {"u6", "u4"}}},
+		{StrategyWeighted, map[string][]string{"pr-1": {"u3", "u5"}, "pr-2": {"u4", "u5"}, "pr-3": {"u6", "u5"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			cfg := config.PR{MaxReviewers: 2, AssignOnlyActive: true, Strategy: tt.strategy, HashPick: true}
+
+			// seed и порядок кандидатов разные, pull_request_id тот же
+			first := newTestService(t, cfg, members, nil, WithRandSource(rand.NewSource(1)))
+			second := newTestService(t, cfg, shuffled, nil, WithRandSource(rand.NewSource(2)))
+
+			for prId, want := range tt.want {
+				if got := createPR(t, first, prId); !slices.Equal(got, want) {
+					t.Errorf("%s: reviewers = %v, want %v", prId, got, want)
+				}
+				// повтор на том же сервисе не зависит от предыдущих назначений
+				if got := createPR(t, first, prId); !slices.Equal(got, want) {
+					t.Errorf("%s: retry = %v, want %v", prId, got, want)
+				}
+				if got := createPR(t, second, prId); !slices.Equal(got, want) {
+					t.Errorf("%s: shuffled candidates = %v, want %v", prId, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const maxTagLength = 64
+
+// UserSetTags целиком заменяет навыки пользователя
+func (s *Service) UserSetTags(ctx context.Context, userId string, tags []string) (*domain.User, error) {
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.user.SetTags(ctx, userId, normalized)
+	if err != nil {
+		s.log.Error("service.UserSetTags: failed to update tags in repo", slog.String("user_id", userId), slog.Any("error", err))
+		return nil, err
+	}
+	return user, nil
+}
+
+// coverTags жадно подбирает ревьюверов так, чтобы каждый навык из need был хотя бы у одного из них.
+// Кандидаты берутся из команды политики и резервных команд, своя команда в приоритете.
+func (s *Service) coverTags(ctx context.Context, pr *domain.PullRequest, policy domain.TeamPolicy, exclude map[string]bool, need []string, count int) ([]domain.User, bool, error) {
+	picked := make([]domain.User, 0, count)
+	if len(need) == 0 {
+		return picked, false, nil
+	}
+
+	chain, err := s.teamChain(ctx, policy)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pool := make([]domain.User, 0)
+	saturated := false
+	for _, teamName := range chain {
+		candidates, dropped, err := s.teamPool(ctx, policy, teamName, exclude)
+		if err != nil {
+			return nil, false, err
+		}
+		saturated = saturated || dropped
+
+		candidates = sortedByID(candidates)
+		rnd := s.pickRand(pr.PullRequestId, candidates)
+		rnd.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+		pool = append(pool, candidates...)
+	}
+
+	tags, err := s.user.ListTags(ctx, reviewersIds(pool))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list candidates tags: %w", err)
+	}
+
+	uncovered := make(map[string]bool, len(need))
+	for _, tag := range need {
+		uncovered[tag] = true
+	}
+
+	for len(picked) < count && len(uncovered) > 0 {
+		best, bestGain := -1, 0
+		for i, u := range pool {
+			gain := 0
+			for _, tag := range tags[u.ID] {
+				if uncovered[tag] {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		u := pool[best]
+		for _, tag := range tags[u.ID] {
+			delete(uncovered, tag)
+		}
+		exclude[u.ID] = true
+		picked = append(picked, u)
+		pool = append(pool[:best], pool[best+1:]...)
+	}
+
+	if len(uncovered) > 0 {
+		missing := make([]string, 0, len(uncovered))
+		for tag := range uncovered {
+			missing = append(missing, tag)
+		}
+		slices.Sort(missing)
+		return nil, saturated, fmt.Errorf("%w: %s", domain.ErrTagsNotCovered, strings.Join(missing, ", "))
+	}
+
+	return picked, saturated, nil
+}
+
+// uncoveredTags возвращает требуемые навыки, которых нет ни у одного из reviewerIDs
+func (s *Service) uncoveredTags(ctx context.Context, required []string, reviewerIDs []string) ([]string, error) {
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	tags, err := s.user.ListTags(ctx, reviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := make(map[string]bool)
+	for _, userTags := range tags {
+		for _, tag := range userTags {
+			covered[tag] = true
+		}
+	}
+
+	need := make([]string, 0, len(required))
+	for _, tag := range required {
+		if !covered[tag] {
+			need = append(need, tag)
+		}
+	}
+	return need, nil
+}
+
+// normalizeTags приводит навыки к нижнему регистру и убирает повторы
+func normalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > maxTagLength {
+			return nil, domain.ErrInvalidTag
+		}
+		if !slices.Contains(res, tag) {
+			res = append(res, tag)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// StaleReviews возвращает неначатые ревью открытых PR, назначенные не позже assignedBefore
+func (s *Service) StaleReviews(ctx context.Context, assignedBefore time.Time) ([]domain.StaleReview, error) {
+	reviews, err := s.pr.ListStaleReviews(ctx, assignedBefore)
+	if err != nil {
+		s.log.Error("service.StaleReviews: failed to list stale reviews", slog.Any("error", err))
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// RemindReviewer фиксирует напоминание; само сообщение уходит через outbox
+func (s *Service) RemindReviewer(ctx context.Context, prId, reviewerId string, at time.Time) error {
+	if err := s.pr.RecordReminder(ctx, prId, reviewerId, at); err != nil {
+		s.log.Error("service.RemindReviewer: failed to record reminder", slog.String("pr_id", prId), slog.String("user_id", reviewerId), slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// PullRequestEscalate переназначает просроченное ревью так же, как /pullRequest/reassign,
+// но от имени сервиса и с причиной SLA_ESCALATED
+func (s *Service) PullRequestEscalate(ctx context.Context, prId, reviewerId string) (*domain.PullRequest, string, error) {
+	return s.reassign(ctx, prId, reviewerId, "", domain.AssignmentReasonSLAEscalated)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+// defaultStatsWindow — окно статистики, если from не задан
+const defaultStatsWindow = 30 * 24 * time.Hour
+
+// statsWindow подставляет границы по умолчанию: to — сейчас, from — to минус 30 дней
+func statsWindow(from, to *time.Time) (time.Time, time.Time, error) {
+	end := time.Now().In(time.UTC)
+	if to != nil {
+		end = *to
+	}
+	start := end.Add(-defaultStatsWindow)
+	if from != nil {
+		start = *from
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, domain.ErrInvalidStatsWindow
+	}
+	return start, end, nil
+}
+
+func (s *Service) StatsLatency(ctx context.Context, from, to *time.Time) (*domain.LatencyStats, error) {
+	start, end, err := statsWindow(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.pr.LatencyStats(ctx, start, end)
+	if err != nil {
+		s.log.Error("service.StatsLatency: failed to get latency stats", slog.Time("from", start), slog.Time("to", end), slog.Any("error", err))
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetAssignmentStats считает назначения ревьюверов по PR, отобранным фильтром; без from и to — за всё время
+func (s *Service) GetAssignmentStats(ctx context.Context, filter domain.StatsFilter) (*domain.AssignmentStats, error) {
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return nil, domain.ErrInvalidStatsWindow
+	}
+
+	if err := checkStatusFilter(filter.Status); err != nil {
+		return nil, err
+	}
+
+	if filter.TeamName != "" {
+		if _, err := s.team.GetTeam(ctx, filter.TeamName); err != nil {
+			if !errors.Is(err, domain.ErrTeamNotFound) {
+				s.log.Error("service.GetAssignmentStats: failed to get team", slog.String("team_name", filter.TeamName), slog.Any("error", err))
+			}
+			return nil, err
+		}
+	}
+
+	stats, err := s.pr.AssignmentStats(ctx, filter)
+	if err != nil {
+		s.log.Error("service.GetAssignmentStats: failed to aggregate assignment stats", slog.Any("error", err))
+		return nil, err
+	}
+	return stats, nil
+}
+
+// checkStatusFilter проверяет фильтр по статусу PR; пустой статус — без фильтра
+func checkStatusFilter(status domain.PullRequestStatus) error {
+	switch status {
+	case "", domain.PRStatusDraft, domain.PRStatusOpen, domain.PRStatusClosed, domain.PRStatusMerged:
+		return nil
+	default:
+		return domain.ErrInvalidStatusFilter
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+// webhookEvents — события, на которые можно подписаться
+var webhookEvents = []domain.OutboxEventType{
+	domain.OutboxPRCreated,
+	domain.OutboxPRReassigned,
+	domain.OutboxPRReviewersChanged,
+	domain.OutboxPRStatusChanged,
+	domain.OutboxPRMerged,
+	domain.OutboxPRReviewReminder,
+	domain.OutboxTeamAssignmentSkew,
+}
+
+type WebhookRepo interface {
+	CreateSubscription(ctx context.Context, sub domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]domain.WebhookDelivery, error)
+}
+
+// WebhookDispatcher отправляет сохранённые доставки подписчикам
+type WebhookDispatcher interface {
+	Redeliver(ctx context.Context, deliveryId int64) (*domain.WebhookDelivery, error)
+}
+
+// WithWebhookDispatcher задаёт отправителя для повторной доставки вебхуков
+func WithWebhookDispatcher(dispatcher WebhookDispatcher) Option {
+	return func(s *Service) {
+		s.dispatcher = dispatcher
+	}
+}
+
+// WebhookCreate создаёт подписку. Если секрет не задан, он генерируется и возвращается один раз.
+func (s *Service) WebhookCreate(ctx context.Context, sub domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", domain.ErrInvalidWebhook)
+	}
+
+	events := make([]domain.OutboxEventType, 0, len(sub.Events))
+	for _, e := range sub.Events {
+		if !slices.Contains(webhookEvents, e) {
+			return nil, fmt.Errorf("%w: unknown event %q", domain.ErrInvalidWebhook, e)
+		}
+		if !slices.Contains(events, e) {
+			events = append(events, e)
+		}
+	}
+	sub.Events = events
+
+	if sub.TeamName != nil {
+		if _, err := s.team.GetTeam(ctx, *sub.TeamName); err != nil {
+			s.log.Error("service.WebhookCreate: failed to get team", slog.String("team_name", *sub.TeamName), slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	if sub.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+
+	created, err := s.hooks.CreateSubscription(ctx, sub)
+	if err != nil {
+		s.log.Error("service.WebhookCreate: failed to create subscription in repo", slog.String("url", sub.URL), slog.Any("error", err))
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *Service) WebhookList(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	subs, err := s.hooks.ListSubscriptions(ctx)
+	if err != nil {
+		s.log.Error("service.WebhookList: failed to list subscriptions from repo", slog.Any("error", err))
+		return nil, err
+	}
+	return subs, nil
+}
+
+func (s *Service) WebhookDelete(ctx context.Context, id int64) error {
+	if err := s.hooks.DeleteSubscription(ctx, id); err != nil {
+		s.log.Error("service.WebhookDelete: failed to delete subscription", slog.Int64("id", id), slog.Any("error", err))
+		return err
+	}
+	return nil
+}
+
+// WebhookDeliveries возвращает последние доставки подписки; limit <= 0 — значение по умолчанию
+func (s *Service) WebhookDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]domain.WebhookDelivery, error) {
+	if _, err := s.hooks.GetSubscription(ctx, subscriptionId); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	limit = min(limit, maxDeliveriesLimit)
+
+	deliveries, err := s.hooks.ListDeliveries(ctx, subscriptionId, limit)
+	if err != nil {
+		s.log.Error("service.WebhookDeliveries: failed to list deliveries from repo", slog.Int64("subscription_id", subscriptionId), slog.Any("error", err))
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// WebhookRedeliver синхронно повторяет доставку и возвращает её с новым результатом
+func (s *Service) WebhookRedeliver(ctx context.Context, deliveryId int64) (*domain.WebhookDelivery, error) {
+	if s.dispatcher == nil {
+		return nil, fmt.Errorf("webhook dispatcher is not configured")
+	}
+
+	delivery, err := s.dispatcher.Redeliver(ctx, deliveryId)
+	if err != nil {
+		s.log.Error("service.WebhookRedeliver: failed to redeliver", slog.Int64("delivery_id", deliveryId), slog.Any("error", err))
+		return nil, err
+	}
+	return delivery, nil
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/3eLLenKa/test-avito/internal/domain"
+	"github.com/3eLLenKa/test-avito/internal/outbox"
+)
+
+// SignatureHeader — заголовок с HMAC-SHA256 тела запроса, ключ — секрет подписки
+const SignatureHeader = "X-Signature-256"
+
+type Store interface {
+	MatchSubscriptions(ctx context.Context, eventType domain.OutboxEventType, authorId, teamName string) ([]domain.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+	EnsureDelivery(ctx context.Context, d domain.WebhookDelivery) (*domain.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id int64) (*domain.WebhookDelivery, error)
+	FinishDelivery(ctx context.Context, id int64, status domain.WebhookDeliveryStatus, responseCode *int, errText string) (*domain.WebhookDelivery, error)
+}
+
+// Dispatcher рассылает события outbox подписчикам и записывает каждую доставку.
+// Реализует outbox.Sink: при ошибке relay повторит событие, а уже доставленным
+// подписчикам оно повторно не отправится.
+type Dispatcher struct {
+	store  Store
+	client *http.Client
+}
+
+func NewDispatcher(store Store, timeout time.Duration) *Dispatcher {
+	return &Dispatcher{
+		store:  store,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (d *Dispatcher) Name() string {
+	return "subscriptions"
+}
+
+func (d *Dispatcher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	// события PR относятся к команде автора, события команды несут team_name
+	var scope struct {
+		AuthorID string `json:"author_id"`
+		TeamName string `json:"team_name"`
+	}
+	if err := json.Unmarshal(event.Payload, &scope); err != nil {
+		return fmt.Errorf("failed to decode payload of event %d: %w", event.ID, err)
+	}
+
+	subs, err := d.store.MatchSubscriptions(ctx, event.Type, scope.AuthorID, scope.TeamName)
+	if err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(outbox.NewEnvelope(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event %d: %w", event.ID, err)
+	}
+
+	var errs []error
+	for _, sub := range subs {
+		delivery, err := d.store.EnsureDelivery(ctx, domain.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        body,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if delivery.Status == domain.WebhookDeliverySuccess {
+			continue
+		}
+
+		if delivery, err = d.deliver(ctx, sub, *delivery); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if delivery.Status != domain.WebhookDeliverySuccess {
+			errs = append(errs, fmt.Errorf("delivery %d to %s failed: %s", delivery.ID, sub.URL, delivery.Error))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Redeliver повторно отправляет сохранённую доставку независимо от её статуса
+func (d *Dispatcher) Redeliver(ctx context.Context, deliveryId int64) (*domain.WebhookDelivery, error) {
+	delivery, err := d.store.GetDelivery(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := d.store.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	return d.deliver(ctx, *sub, *delivery)
+}
+
+// deliver отправляет доставку и сохраняет результат; ошибка — только если результат не удалось сохранить
+func (d *Dispatcher) deliver(ctx context.Context, sub domain.WebhookSubscription, delivery domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	code, err := d.send(ctx, sub, delivery)
+
+	status, errText := domain.WebhookDeliverySuccess, ""
+	if err != nil {
+		status, errText = domain.WebhookDeliveryFailed, err.Error()
+	}
+
+	var responseCode *int
+	if code != 0 {
+		responseCode = &code
+	}
+	return d.store.FinishDelivery(ctx, delivery.ID, status, responseCode, errText)
+}
+
+// send возвращает код ответа (0, если ответа не было) и ошибку для кодов вне 2xx
+func (d *Dispatcher) send(ctx context.Context, sub domain.WebhookSubscription, delivery domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set("X-Event-Type", string(delivery.EventType))
+	req.Header.Set("X-Delivery-Id", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign возвращает подпись тела в формате "sha256=<hex>"
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_policies (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    min_reviewers INTEGER NOT NULL DEFAULT 0 CHECK (min_reviewers >= 0),
+    max_reviewers INTEGER NOT NULL CHECK (max_reviewers >= min_reviewers),
+    assign_only_active BOOLEAN NOT NULL DEFAULT TRUE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_policies;
+-- +goose StatementEnd
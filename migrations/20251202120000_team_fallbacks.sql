@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    fallback_team VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    PRIMARY KEY (team_name, position),
+    UNIQUE (team_name, fallback_team),
+    CHECK (team_name <> fallback_team)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_fallbacks;
+-- +goose StatementEnd
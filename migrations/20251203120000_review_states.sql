@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN state VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (state IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    ADD COLUMN state_updated_at TIMESTAMP WITH TIME ZONE NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS state_updated_at,
+    DROP COLUMN IF EXISTS state;
+-- +goose StatementEnd
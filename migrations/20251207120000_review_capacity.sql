@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER CHECK (max_open_reviews >= 0);
+
+ALTER TABLE team_policies ADD COLUMN IF NOT EXISTS default_capacity INTEGER NOT NULL DEFAULT 0 CHECK (default_capacity >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_policies DROP COLUMN IF EXISTS default_capacity;
+
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
+-- +goose StatementEnd
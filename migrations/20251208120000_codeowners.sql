@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS codeowner_rules (
+    position INTEGER PRIMARY KEY,
+    pattern TEXT NOT NULL,
+    user_ids TEXT[] NOT NULL DEFAULT '{}',
+    team_names TEXT[] NOT NULL DEFAULT '{}'
+);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS changed_paths TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS changed_paths;
+
+DROP TABLE IF EXISTS codeowner_rules;
+-- +goose StatementEnd
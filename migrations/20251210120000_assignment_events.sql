@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS assignment_events (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    event_type VARCHAR(32) NOT NULL CHECK (event_type IN ('ASSIGNED', 'UNASSIGNED', 'REASSIGNED')),
+    actor_id VARCHAR(255) NULL,
+    old_reviewer_id VARCHAR(255) NULL,
+    new_reviewer_id VARCHAR(255) NULL,
+    reason VARCHAR(64) NOT NULL DEFAULT '',
+    strategy VARCHAR(32) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_events_pr ON assignment_events (pull_request_id, id);
+CREATE INDEX IF NOT EXISTS idx_assignment_events_old_reviewer ON assignment_events (old_reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_assignment_events_new_reviewer ON assignment_events (new_reviewer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS assignment_events;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS review_reminders (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE RESTRICT,
+    reminded_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reminders_count INT NOT NULL DEFAULT 1,
+    PRIMARY KEY (pull_request_id, reviewer_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_reminders;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP WITH TIME ZONE NULL,
+    ADD COLUMN IF NOT EXISTS responded_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- для уже назначенных: последнее событие назначения из журнала, иначе создание PR;
+-- точное время первого ответа не сохранялось — берём время последнего вердикта
+UPDATE pull_request_reviewers prr
+SET assigned_at = COALESCE((
+        SELECT MAX(ae.created_at)
+        FROM assignment_events ae
+        WHERE ae.pull_request_id = prr.pull_request_id
+          AND ae.new_reviewer_id = prr.reviewer_id
+    ), pr.created_at),
+    responded_at = CASE WHEN prr.state <> 'PENDING' THEN prr.state_updated_at END
+FROM pull_requests pr
+WHERE pr.pull_request_id = prr.pull_request_id;
+
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN assigned_at SET DEFAULT NOW(),
+    ALTER COLUMN assigned_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_responded_at ON pull_request_reviewers (responded_at);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at ON pull_requests (merged_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+DROP INDEX IF EXISTS idx_pull_request_reviewers_responded_at;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS responded_at,
+    DROP COLUMN IF EXISTS assigned_at;
+-- +goose StatementEnd
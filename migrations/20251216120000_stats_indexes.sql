@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at ON pull_requests (created_at);
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_reviewer ON pull_request_reviewers (reviewer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_request_reviewers_reviewer;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
+-- +goose StatementEnd
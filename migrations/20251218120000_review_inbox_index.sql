@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- входящие ревью пользователя: поиск по reviewer_id с фильтром по вердикту
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_reviewer_state ON pull_request_reviewers (reviewer_id, state);
+DROP INDEX IF EXISTS idx_pull_request_reviewers_reviewer;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_reviewer ON pull_request_reviewers (reviewer_id);
+DROP INDEX IF EXISTS idx_pull_request_reviewers_reviewer_state;
+-- +goose StatementEnd
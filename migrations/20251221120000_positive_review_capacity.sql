@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- личный лимит 0 больше не допускается: как и у команды, 0 не может означать «не назначать»;
+-- исключать из назначений нужно через is_active или период недоступности
+UPDATE users SET max_open_reviews = NULL WHERE max_open_reviews = 0;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_max_open_reviews_check;
+ALTER TABLE users ADD CONSTRAINT users_max_open_reviews_check CHECK (max_open_reviews > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_max_open_reviews_check;
+ALTER TABLE users ADD CONSTRAINT users_max_open_reviews_check CHECK (max_open_reviews >= 0);
+-- +goose StatementEnd